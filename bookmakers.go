@@ -0,0 +1,58 @@
+package sportmonks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// Bookmaker provides a struct representation of a Bookmaker resource.
+type Bookmaker struct {
+	ID       int    `json:"id"`
+	LegacyID *int   `json:"legacy_id"`
+	Name     string `json:"name"`
+}
+
+// Bookmakers fetches Bookmaker resources. The endpoint used within this method is paginated, to select the required
+// page use the 'page' method argument. Pagination information including current page and count are included within
+// the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Bookmakers(ctx context.Context, page int, query *Query) ([]Bookmaker, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[Bookmaker](ctx, c, bookmakersURI, values)
+}
+
+// BookmakersIter returns an iterator over Bookmaker resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) BookmakersIter(ctx context.Context, query *Query) iter.Seq2[Bookmaker, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Bookmaker, *ResponseDetails, error) {
+		return c.Bookmakers(ctx, page, query)
+	})
+}
+
+// BookmakerByID fetches a Bookmaker resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) BookmakerByID(ctx context.Context, id int, query *Query) (*Bookmaker, *ResponseDetails, error) {
+	path := fmt.Sprintf(bookmakersURI+"/%d", id)
+
+	return getOne[Bookmaker](ctx, c, path, query.Values())
+}
+
+// BookmakersByFixtureID fetches the Bookmaker resources offering odds on a fixture. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) BookmakersByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Bookmaker, *ResponseDetails, error) {
+	path := fmt.Sprintf(bookmakersFixtureURI+"/%d", fixtureID)
+
+	return getMany[Bookmaker](ctx, c, path, query.Values())
+}
+
+// BookmakerSearch fetches the Bookmaker resources with a name matching the search term. Use the query to enrich and
+// filter the response data.
+func (c *HTTPClient) BookmakerSearch(ctx context.Context, name string, query *Query) ([]Bookmaker, *ResponseDetails, error) {
+	path := bookmakersSearchURI + "/" + url.PathEscape(name)
+
+	return getMany[Bookmaker](ctx, c, path, query.Values())
+}
@@ -0,0 +1,152 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var bookmakersResponse = `{
+	"data": [
+		{
+			"id": 2,
+			"legacy_id": 2,
+			"name": "bet365"
+		}
+	],
+	"pagination": {
+		"count": 1,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Bookmaker"
+	},
+	"timezone": "UTC"
+}`
+
+var bookmakerResponse = `{
+	"data": {
+		"id": 2,
+		"legacy_id": 2,
+		"name": "bet365"
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Bookmaker"
+	},
+	"timezone": "UTC"
+}`
+
+func TestBookmakers(t *testing.T) {
+	url := defaultBaseURL + "/odds/bookmakers?api_token=api-key&page=1"
+
+	t.Run("returns Bookmaker struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, bookmakersResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmakers, details, err := client.Bookmakers(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertBookmaker(t, &bookmakers[0])
+		assert.Equal(t, "Bookmaker", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmakers, _, err := client.Bookmakers(context.Background(), 1, nil)
+
+		if bookmakers != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", bookmakers)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestBookmakerByID(t *testing.T) {
+	url := defaultBaseURL + "/odds/bookmakers/2?api_token=api-key"
+
+	t.Run("returns a single Bookmaker struct", func(t *testing.T) {
+		server := mockResponseServer(t, bookmakerResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmaker, _, err := client.BookmakerByID(context.Background(), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertBookmaker(t, bookmaker)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmaker, _, err := client.BookmakerByID(context.Background(), 2, nil)
+
+		if bookmaker != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", bookmaker)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestBookmakersByFixtureID(t *testing.T) {
+	t.Run("returns Bookmaker struct slice", func(t *testing.T) {
+		url := defaultBaseURL + "/odds/bookmakers/fixtures/19134492?api_token=api-key"
+
+		server := mockResponseServer(t, bookmakersResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmakers, _, err := client.BookmakersByFixtureID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertBookmaker(t, &bookmakers[0])
+	})
+}
+
+func TestBookmakerSearch(t *testing.T) {
+	t.Run("returns Bookmaker struct slice", func(t *testing.T) {
+		url := defaultBaseURL + "/odds/bookmakers/search/bet%20365?api_token=api-key"
+
+		server := mockResponseServer(t, bookmakersResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bookmakers, _, err := client.BookmakerSearch(context.Background(), "bet 365", nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertBookmaker(t, &bookmakers[0])
+	})
+}
+
+func assertBookmaker(t *testing.T, bookmaker *Bookmaker) {
+	assert.Equal(t, 2, bookmaker.ID)
+	assert.Equal(t, 2, *bookmaker.LegacyID)
+	assert.Equal(t, "bet365", bookmaker.Name)
+}
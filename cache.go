@@ -0,0 +1,261 @@
+package sportmonks
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw API response bodies keyed by resource path and query.
+type Cache interface {
+	// Get returns the value stored for key and whether a value that has not expired was found.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key for the duration of ttl.
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes the value stored for key.
+	Delete(key string)
+}
+
+// DefaultCacheTTLs returns cache durations for the reference data endpoints that rarely change.
+func DefaultCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		continentsURI:   24 * time.Hour,
+		countriesURI:    24 * time.Hour,
+		leaguesURI:      24 * time.Hour,
+		seasonsURI:      6 * time.Hour,
+		stagesURI:       6 * time.Hour,
+		stagesSeasonURI: 6 * time.Hour,
+		statesURI:       24 * time.Hour,
+		typesURI:        24 * time.Hour,
+		venuesURI:       24 * time.Hour,
+	}
+}
+
+type cacheOption int
+
+const (
+	cacheBypass cacheOption = iota + 1
+	cacheRefreshOption
+)
+
+type cacheOptionKey struct{}
+
+// WithoutCache returns a context that causes requests made with it to skip the client Cache entirely, neither
+// reading from nor writing to it.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheOptionKey{}, cacheBypass)
+}
+
+// WithCacheRefresh returns a context that causes requests made with it to ignore any cached response and replace it
+// with a fresh response from the API.
+func WithCacheRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheOptionKey{}, cacheRefreshOption)
+}
+
+func cacheRefresh(ctx context.Context) bool {
+	return ctx.Value(cacheOptionKey{}) == cacheRefreshOption
+}
+
+// cacheEntry returns the cache key for a request and the duration its response should be cached for. A zero duration
+// means the response is not cached.
+func (c *HTTPClient) cacheEntry(ctx context.Context, path string, query url.Values) (string, time.Duration) {
+	if c.Cache == nil || ctx.Value(cacheOptionKey{}) == cacheBypass {
+		return "", 0
+	}
+
+	var ttl time.Duration
+	var match string
+
+	for prefix, d := range c.CacheTTLs {
+		if strings.HasPrefix(path, prefix) && len(prefix) > len(match) {
+			match = prefix
+			ttl = d
+		}
+	}
+
+	return cacheKey(path, query), ttl
+}
+
+// cacheKey builds a canonical key from a resource path and query, excluding the API token.
+func cacheKey(path string, query url.Values) string {
+	values := url.Values{}
+
+	for k, v := range query {
+		if k != "api_token" {
+			values[k] = v
+		}
+	}
+
+	if len(values) == 0 {
+		return path
+	}
+
+	return path + "?" + values.Encode()
+}
+
+// LRUCache is an in-memory Cache holding a fixed number of entries, evicting the least recently used entry when full.
+type LRUCache struct {
+	capacity int
+	mu       sync.Mutex
+	items    map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewLRUCache creates a new LRUCache holding up to capacity entries.
+func NewLRUCache(capacity int) *LRUCache {
+	return &LRUCache{
+		capacity: capacity,
+		items:    map[string]*list.Element{},
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+// Get returns the value stored for key and whether a value that has not expired was found.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.items[key]
+
+	if !ok {
+		return nil, false
+	}
+
+	entry := el.Value.(*lruEntry)
+
+	if !l.now().Before(entry.expiresAt) {
+		l.order.Remove(el)
+		delete(l.items, key)
+		return nil, false
+	}
+
+	l.order.MoveToFront(el)
+
+	return entry.value, true
+}
+
+// Set stores value for key for the duration of ttl.
+func (l *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry := &lruEntry{key: key, value: value, expiresAt: l.now().Add(ttl)}
+
+	if el, ok := l.items[key]; ok {
+		el.Value = entry
+		l.order.MoveToFront(el)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(entry)
+
+	for l.capacity > 0 && l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruEntry).key)
+	}
+}
+
+// Delete removes the value stored for key.
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.items[key]; ok {
+		l.order.Remove(el)
+		delete(l.items, key)
+	}
+}
+
+// FileCache is a Cache storing each entry as a file within a directory, allowing cached responses to survive process
+// restarts. Each file holds the entry expiry time on its first line followed by the cached value.
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates a new FileCache storing entries within dir, creating the directory if it does not exist.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// Get returns the value stored for key and whether a value that has not expired was found.
+func (f *FileCache) Get(key string) ([]byte, bool) {
+	b, err := os.ReadFile(f.path(key))
+
+	if err != nil {
+		return nil, false
+	}
+
+	header, value, ok := strings.Cut(string(b), "\n")
+
+	if !ok {
+		return nil, false
+	}
+
+	expiresAt, err := strconv.ParseInt(header, 10, 64)
+
+	if err != nil || f.now().UnixNano() >= expiresAt {
+		f.Delete(key)
+		return nil, false
+	}
+
+	return []byte(value), true
+}
+
+// Set stores value for key for the duration of ttl. Failures to write are ignored, the entry is simply not cached.
+func (f *FileCache) Set(key string, value []byte, ttl time.Duration) {
+	header := strconv.FormatInt(f.now().Add(ttl).UnixNano(), 10) + "\n"
+
+	tmp, err := os.CreateTemp(f.dir, ".tmp-*")
+
+	if err != nil {
+		return
+	}
+
+	_, err = tmp.Write(append([]byte(header), value...))
+
+	if e := tmp.Close(); err == nil {
+		err = e
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return
+	}
+
+	if os.Rename(tmp.Name(), f.path(key)) != nil {
+		_ = os.Remove(tmp.Name())
+	}
+}
+
+// Delete removes the value stored for key.
+func (f *FileCache) Delete(key string) {
+	_ = os.Remove(f.path(key))
+}
+
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,212 @@
+package sportmonks
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var cachedVenueResponse = `{
+	"data": {
+		"id": 8,
+		"name": "Anfield"
+	}
+}`
+
+func countingServer(calls *int, body string) *http.Client {
+	return newTestClient(func(req *http.Request) *http.Response {
+		*calls++
+		return stringResponse(200, body)
+	})
+}
+
+func TestHTTPClientCache(t *testing.T) {
+	t.Run("serves repeated requests for cached resources from the cache", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(countingServer(&calls, cachedVenueResponse))
+		client.SetCache(NewLRUCache(10), DefaultCacheTTLs())
+
+		for i := 0; i < 3; i++ {
+			venue, _, err := client.VenueByID(context.Background(), 8, nil)
+
+			if err != nil {
+				t.Fatalf("Test failed, expected nil, got %s", err.Error())
+			}
+
+			assert.Equal(t, "Anfield", venue.Name)
+		}
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not cache resources without a ttl", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(countingServer(&calls, coachResponse))
+		client.SetCache(NewLRUCache(10), DefaultCacheTTLs())
+
+		_, _, _ = client.CoachByID(context.Background(), 2, nil)
+		_, _, _ = client.CoachByID(context.Background(), 2, nil)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("uses the longest matching path prefix", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(countingServer(&calls, cachedVenueResponse))
+		client.SetCache(NewLRUCache(10), map[string]time.Duration{
+			"/football":        time.Hour,
+			"/football/venues": 0,
+		})
+
+		_, _, _ = client.VenueByID(context.Background(), 8, nil)
+		_, _, _ = client.VenueByID(context.Background(), 8, nil)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("bypasses the cache per call", func(t *testing.T) {
+		calls := 0
+
+		cache := NewLRUCache(10)
+
+		client := newTestHTTPClient(countingServer(&calls, cachedVenueResponse))
+		client.SetCache(cache, DefaultCacheTTLs())
+
+		_, _, _ = client.VenueByID(WithoutCache(context.Background()), 8, nil)
+		_, _, _ = client.VenueByID(WithoutCache(context.Background()), 8, nil)
+
+		_, ok := cache.Get("/football/venues/8")
+
+		assert.Equal(t, 2, calls)
+		assert.False(t, ok)
+	})
+
+	t.Run("refreshes the cached response per call", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(countingServer(&calls, cachedVenueResponse))
+		client.SetCache(NewLRUCache(10), DefaultCacheTTLs())
+
+		_, _, _ = client.VenueByID(context.Background(), 8, nil)
+		_, _, _ = client.VenueByID(WithCacheRefresh(context.Background()), 8, nil)
+		_, _, _ = client.VenueByID(context.Background(), 8, nil)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("does not cache error responses", func(t *testing.T) {
+		calls := 0
+
+		server := sequenceServer(&calls, stringResponse(404, errorResponse), stringResponse(200, cachedVenueResponse))
+
+		client := newTestHTTPClient(server)
+		client.SetCache(NewLRUCache(10), DefaultCacheTTLs())
+
+		_, _, err := client.VenueByID(context.Background(), 8, nil)
+
+		assertError(t, err)
+
+		venue, _, err := client.VenueByID(context.Background(), 8, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Anfield", venue.Name)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestCacheKey(t *testing.T) {
+	t.Run("excludes api token and orders query parameters", func(t *testing.T) {
+		query := url.Values{
+			"page":      {"2"},
+			"api_token": {"secret"},
+			"include":   {"countries"},
+		}
+
+		assert.Equal(t, "/core/continents?include=countries&page=2", cacheKey("/core/continents", query))
+	})
+
+	t.Run("returns path when query is empty", func(t *testing.T) {
+		assert.Equal(t, "/football/venues/8", cacheKey("/football/venues/8", url.Values{"api_token": {"secret"}}))
+	})
+}
+
+func testCaches(t *testing.T) map[string]Cache {
+	fileCache, err := NewFileCache(t.TempDir())
+
+	if err != nil {
+		t.Fatalf("Test failed, expected nil, got %s", err.Error())
+	}
+
+	return map[string]Cache{
+		"lru":  NewLRUCache(2),
+		"file": fileCache,
+	}
+}
+
+func TestCacheImplementations(t *testing.T) {
+	for name, cache := range testCaches(t) {
+		t.Run(name+" stores and returns values", func(t *testing.T) {
+			cache.Set("a", []byte(`{"data":1}`), time.Hour)
+
+			value, ok := cache.Get("a")
+
+			assert.True(t, ok)
+			assert.Equal(t, []byte(`{"data":1}`), value)
+		})
+
+		t.Run(name+" does not return expired values", func(t *testing.T) {
+			cache.Set("expired", []byte("value"), -time.Second)
+
+			_, ok := cache.Get("expired")
+
+			assert.False(t, ok)
+		})
+
+		t.Run(name+" deletes values", func(t *testing.T) {
+			cache.Set("deleted", []byte("value"), time.Hour)
+			cache.Delete("deleted")
+
+			_, ok := cache.Get("deleted")
+
+			assert.False(t, ok)
+		})
+	}
+
+	t.Run("lru evicts least recently used entry", func(t *testing.T) {
+		cache := NewLRUCache(2)
+
+		cache.Set("a", []byte("a"), time.Hour)
+		cache.Set("b", []byte("b"), time.Hour)
+		cache.Get("a")
+		cache.Set("c", []byte("c"), time.Hour)
+
+		_, okA := cache.Get("a")
+		_, okB := cache.Get("b")
+		_, okC := cache.Get("c")
+
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+	})
+
+	t.Run("file cache persists between instances", func(t *testing.T) {
+		dir := t.TempDir()
+
+		first, _ := NewFileCache(dir)
+		first.Set("/core/continents", []byte("continents"), time.Hour)
+
+		second, _ := NewFileCache(dir)
+
+		value, ok := second.Get("/core/continents")
+
+		assert.True(t, ok)
+		assert.Equal(t, []byte("continents"), value)
+	})
+}
@@ -0,0 +1,105 @@
+package sportmonks
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Client provides every endpoint method of the API. HTTPClient is the implementation used to make requests to the
+// API, the sportmonkstest package provides an in-memory implementation for use in tests.
+type Client interface {
+	Bookmakers(ctx context.Context, page int, query *Query) ([]Bookmaker, *ResponseDetails, error)
+	BookmakersIter(ctx context.Context, query *Query) iter.Seq2[Bookmaker, error]
+	BookmakerByID(ctx context.Context, id int, query *Query) (*Bookmaker, *ResponseDetails, error)
+	BookmakersByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Bookmaker, *ResponseDetails, error)
+	BookmakerSearch(ctx context.Context, name string, query *Query) ([]Bookmaker, *ResponseDetails, error)
+	CoachByID(ctx context.Context, id int, query *Query) (*Coach, *ResponseDetails, error)
+	CommentariesByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Commentary, *ResponseDetails, error)
+	Continents(ctx context.Context, page int, query *Query) ([]Continent, *ResponseDetails, error)
+	ContinentsIter(ctx context.Context, query *Query) iter.Seq2[Continent, error]
+	ContinentByID(ctx context.Context, id int, query *Query) (*Continent, *ResponseDetails, error)
+	Countries(ctx context.Context, page int, query *Query) ([]Country, *ResponseDetails, error)
+	CountriesIter(ctx context.Context, query *Query) iter.Seq2[Country, error]
+	CountryByID(ctx context.Context, id int, query *Query) (*Country, *ResponseDetails, error)
+	ExpectedGoalsByTeam(ctx context.Context, page int, query *Query) ([]ExpectedGoals, *ResponseDetails, error)
+	ExpectedGoalsByTeamIter(ctx context.Context, query *Query) iter.Seq2[ExpectedGoals, error]
+	ExpectedGoalsByPlayer(ctx context.Context, page int, query *Query) ([]ExpectedGoals, *ResponseDetails, error)
+	ExpectedGoalsByPlayerIter(ctx context.Context, query *Query) iter.Seq2[ExpectedGoals, error]
+	FixtureByID(ctx context.Context, id int, query *Query) (*Fixture, *ResponseDetails, error)
+	FixturesByID(ctx context.Context, ids []int, query *Query, page int) ([]Fixture, *ResponseDetails, error)
+	FixturesByIDIter(ctx context.Context, ids []int, query *Query) iter.Seq2[Fixture, error]
+	FixturesByDate(ctx context.Context, date time.Time, query *Query, page int) ([]Fixture, *ResponseDetails, error)
+	FixturesByDateIter(ctx context.Context, date time.Time, query *Query) iter.Seq2[Fixture, error]
+	FixturesBetween(ctx context.Context, from, to time.Time, query *Query, page int) ([]Fixture, *ResponseDetails, error)
+	FixturesBetweenIter(ctx context.Context, from, to time.Time, query *Query) iter.Seq2[Fixture, error]
+	FixturesBetweenForTeam(ctx context.Context, from, to time.Time, page, teamID int, query *Query) ([]Fixture, *ResponseDetails, error)
+	FixturesBetweenForTeamIter(ctx context.Context, from, to time.Time, teamID int, query *Query) iter.Seq2[Fixture, error]
+	HeadToHead(ctx context.Context, idOne, idTwo int, query *Query, page int) ([]Fixture, *ResponseDetails, error)
+	HeadToHeadIter(ctx context.Context, idOne, idTwo int, query *Query) iter.Seq2[Fixture, error]
+	LatestUpdatedFixtures(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error)
+	Leagues(ctx context.Context, page int, query *Query) ([]League, *ResponseDetails, error)
+	LeaguesIter(ctx context.Context, query *Query) iter.Seq2[League, error]
+	LeagueByID(ctx context.Context, id int, query *Query) (*League, *ResponseDetails, error)
+	Livescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error)
+	InplayLivescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error)
+	LatestLivescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error)
+	AllPrematchOdds(ctx context.Context, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error)
+	AllPrematchOddsIter(ctx context.Context, query *Query) iter.Seq2[PrematchOdds, error]
+	PrematchOddsByFixtureID(ctx context.Context, id int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error)
+	PrematchOddsByFixtureIDIter(ctx context.Context, id int, query *Query) iter.Seq2[PrematchOdds, error]
+	PrematchOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error)
+	PrematchOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *Query) iter.Seq2[PrematchOdds, error]
+	PrematchOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error)
+	PrematchOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *Query) iter.Seq2[PrematchOdds, error]
+	LatestOdds(ctx context.Context, query *Query) ([]PrematchOdds, *ResponseDetails, error)
+	AllInplayOdds(ctx context.Context, query *Query, page int) ([]InplayOdds, *ResponseDetails, error)
+	AllInplayOddsIter(ctx context.Context, query *Query) iter.Seq2[InplayOdds, error]
+	InplayOddsByFixtureID(ctx context.Context, id int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error)
+	InplayOddsByFixtureIDIter(ctx context.Context, id int, query *Query) iter.Seq2[InplayOdds, error]
+	InplayOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error)
+	InplayOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *Query) iter.Seq2[InplayOdds, error]
+	InplayOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error)
+	InplayOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *Query) iter.Seq2[InplayOdds, error]
+	LatestInplayOdds(ctx context.Context, query *Query) ([]InplayOdds, *ResponseDetails, error)
+	Markets(ctx context.Context, page int, query *Query) ([]Market, *ResponseDetails, error)
+	MarketsIter(ctx context.Context, query *Query) iter.Seq2[Market, error]
+	MarketByID(ctx context.Context, id int, query *Query) (*Market, *ResponseDetails, error)
+	MarketSearch(ctx context.Context, name string, query *Query) ([]Market, *ResponseDetails, error)
+	PlayerByID(ctx context.Context, id int, query *Query) (*Player, *ResponseDetails, error)
+	Probabilities(ctx context.Context, page int, query *Query) ([]Probability, *ResponseDetails, error)
+	ProbabilitiesIter(ctx context.Context, query *Query) iter.Seq2[Probability, error]
+	ProbabilitiesByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Probability, *ResponseDetails, error)
+	ValueBets(ctx context.Context, page int, query *Query) ([]ValueBet, *ResponseDetails, error)
+	ValueBetsIter(ctx context.Context, query *Query) iter.Seq2[ValueBet, error]
+	ValueBetsByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]ValueBet, *ResponseDetails, error)
+	PredictabilityByLeagueID(ctx context.Context, leagueID int, query *Query) ([]Predictability, *ResponseDetails, error)
+	RoundByID(ctx context.Context, id int, query *Query) (*Round, *ResponseDetails, error)
+	RoundsBySeasonID(ctx context.Context, id int, query *Query) ([]Round, *ResponseDetails, error)
+	Seasons(ctx context.Context, page int, query *Query) ([]Season, *ResponseDetails, error)
+	SeasonsIter(ctx context.Context, query *Query) iter.Seq2[Season, error]
+	SeasonByID(ctx context.Context, id int, query *Query) (*Season, *ResponseDetails, error)
+	StageByID(ctx context.Context, id int, query *Query) (*Stage, *ResponseDetails, error)
+	StagesBySeasonID(ctx context.Context, id int, query *Query) ([]Stage, *ResponseDetails, error)
+	StandingsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]Standing, *ResponseDetails, error)
+	StandingsByRoundID(ctx context.Context, roundID int, query *Query) ([]Standing, *ResponseDetails, error)
+	LiveStandingsByLeagueID(ctx context.Context, leagueID int, query *Query) ([]Standing, *ResponseDetails, error)
+	StandingCorrectionsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]StandingCorrection, *ResponseDetails, error)
+	States(ctx context.Context, page int, query *Query) ([]FixtureState, *ResponseDetails, error)
+	StatesIter(ctx context.Context, query *Query) iter.Seq2[FixtureState, error]
+	StateByID(ctx context.Context, id int, query *Query) (*FixtureState, *ResponseDetails, error)
+	TeamSquad(ctx context.Context, seasonID, teamID int, query *Query) ([]SquadPlayer, *ResponseDetails, error)
+	CurrentSquad(ctx context.Context, teamID int, query *Query) ([]SquadPlayer, *ResponseDetails, error)
+	TeamByID(ctx context.Context, id int, query *Query) (*Team, *ResponseDetails, error)
+	TeamsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]Team, *ResponseDetails, error)
+	TopScorersBySeasonID(ctx context.Context, seasonID int, query *Query) ([]TopScorer, *ResponseDetails, error)
+	Types(ctx context.Context, page int, query *Query) ([]Type, *ResponseDetails, error)
+	TypesIter(ctx context.Context, query *Query) iter.Seq2[Type, error]
+	TypeByID(ctx context.Context, id int, query *Query) (*Type, *ResponseDetails, error)
+	TypesByEntity(ctx context.Context, query *Query) (map[string][]Type, *ResponseDetails, error)
+	TVStationsByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]TVStation, *ResponseDetails, error)
+	VenueByID(ctx context.Context, id int, query *Query) (*Venue, *ResponseDetails, error)
+	VenuesBySeasonID(ctx context.Context, id int, query *Query) ([]Venue, *ResponseDetails, error)
+}
+
+var _ Client = (*HTTPClient)(nil)
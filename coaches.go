@@ -3,7 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
 )
 
 // Coach provides a struct representation of a Coach resource
@@ -27,19 +26,8 @@ type Coach struct {
 }
 
 // CoachByID fetches a Coach resource by ID.
-func (c *HTTPClient) CoachByID(ctx context.Context, id int) (*Coach, *Meta, error) {
+func (c *HTTPClient) CoachByID(ctx context.Context, id int, query *Query) (*Coach, *ResponseDetails, error) {
 	path := fmt.Sprintf(coachesURI+"/%d", id)
 
-	response := struct {
-		Data *Coach `json:"data"`
-		Meta *Meta  `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, url.Values{}, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getOne[Coach](ctx, c, path, query.Values())
 }
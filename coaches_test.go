@@ -24,7 +24,31 @@ var coachResponse = `{
       "weight": null,
       "date_of_birth": "1973-10-16",
       "gender": "male"
-    }
+    },
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Coach"
+	},
+	"timezone": "UTC"
 }`
 
 func TestCoachByID(t *testing.T) {
@@ -35,7 +59,7 @@ func TestCoachByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		coach, _, err := client.CoachByID(context.Background(), 2)
+		coach, _, err := client.CoachByID(context.Background(), 2, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -49,7 +73,7 @@ func TestCoachByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		coach, _, err := client.CoachByID(context.Background(), 2)
+		coach, _, err := client.CoachByID(context.Background(), 2, nil)
 
 		if coach != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", coach)
@@ -57,6 +81,16 @@ func TestCoachByID(t *testing.T) {
 
 		assertError(t, err)
 	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		server := mockResponseServer(t, coachResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.CoachByID(context.Background(), 2, nil)
+
+		assertResponseDetails(t, details, "Coach")
+	})
 }
 
 func assertCoach(t *testing.T, coach *Coach) {
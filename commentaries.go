@@ -3,7 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
 )
 
 // Commentary provides a struct representation of a Commentary resource.
@@ -18,19 +17,8 @@ type Commentary struct {
 }
 
 // CommentariesByFixtureID fetches Commentary resources associated to a fixture.
-func (c *HTTPClient) CommentariesByFixtureID(ctx context.Context, fixtureID int) ([]Commentary, *Meta, error) {
+func (c *HTTPClient) CommentariesByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Commentary, *ResponseDetails, error) {
 	path := fmt.Sprintf(commentariesFixtureURI+"/%d", fixtureID)
 
-	response := struct {
-		Data []Commentary `json:"data"`
-		Meta *Meta        `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, url.Values{}, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getMany[Commentary](ctx, c, path, query.Values())
 }
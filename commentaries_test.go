@@ -29,7 +29,7 @@ func TestCommentariesByFixtureID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		commentaries, _, err := client.CommentariesByFixtureID(context.Background(), 11867289)
+		commentaries, _, err := client.CommentariesByFixtureID(context.Background(), 11867289, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -43,7 +43,7 @@ func TestCommentariesByFixtureID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		bookmakers, _, err := client.CommentariesByFixtureID(context.Background(), 11867289)
+		bookmakers, _, err := client.CommentariesByFixtureID(context.Background(), 11867289, nil)
 
 		if bookmakers != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", bookmakers)
@@ -3,9 +3,8 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"strconv"
-	"strings"
 )
 
 // Continent provides a struct representation of a Continent resource.
@@ -17,46 +16,29 @@ type Continent struct {
 }
 
 // Continents fetches Continent resources. The endpoint used within this method is paginated, to select the required
-// page use the 'page' method argument. Page information including current page and total page are included within
-// the Meta struct. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) Continents(ctx context.Context, page int, includes []string) ([]Continent, *Meta, error) {
-	values := url.Values{
-		"page":    {strconv.Itoa(page)},
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data []Continent `json:"data"`
-		Meta *Meta       `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, continentsURI, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
-}
+// page use the 'page' method argument. Pagination information including current page and count are included within
+// the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Continents(ctx context.Context, page int, query *Query) ([]Continent, *ResponseDetails, error) {
+	values := query.Values()
 
-// ContinentByID fetches a Continent resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) ContinentByID(ctx context.Context, id int, includes []string) (*Continent, *Meta, error) {
-	path := fmt.Sprintf(continentsURI+"/%d", id)
+	values.Set("page", strconv.Itoa(page))
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	return getMany[Continent](ctx, c, continentsURI, values)
+}
 
-	response := struct {
-		Data *Continent `json:"data"`
-		Meta *Meta      `json:"meta"`
-	}{}
+// ContinentsIter returns an iterator over Continent resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ContinentsIter(ctx context.Context, query *Query) iter.Seq2[Continent, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Continent, *ResponseDetails, error) {
+		return c.Continents(ctx, page, query)
+	})
+}
 
-	err := c.getResource(ctx, path, values, &response)
+// ContinentByID fetches a Continent resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ContinentByID(ctx context.Context, id int, query *Query) (*Continent, *ResponseDetails, error) {
+	path := fmt.Sprintf(continentsURI+"/%d", id)
 
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, response.Meta, err
+	return getOne[Continent](ctx, c, path, values)
 }
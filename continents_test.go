@@ -13,7 +13,38 @@ var continentsResponse = `{
 		  "name": "Europe",
           "code": "EU"
 		}
-	]
+	],
+	"pagination": {
+		"count": 2,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Continent"
+	},
+	"timezone": "UTC"
 }`
 
 var continentsIncludesResponse = `{
@@ -55,9 +86,32 @@ var continentResponse = `{
 		"id": 1,
 		"name": "Europe",
  		"code": "EU"
-	}
-}
-`
+	},
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Continent"
+	},
+	"timezone": "UTC"
+}`
 
 var continentIncludesResponse = `{
 	"data": {
@@ -93,13 +147,13 @@ var continentIncludesResponse = `{
 
 func TestContinents(t *testing.T) {
 	t.Run("returns Continent struct slice", func(t *testing.T) {
-		url := defaultBaseURL + "/core/continents?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/core/continents?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, continentsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		continents, _, err := client.Continents(context.Background(), 1, []string{})
+		continents, _, err := client.Continents(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -115,7 +169,7 @@ func TestContinents(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		continents, _, err := client.Continents(context.Background(), 1, []string{"countries"})
+		continents, _, err := client.Continents(context.Background(), 1, NewQuery().Include("countries"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -128,13 +182,13 @@ func TestContinents(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/core/continents?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/core/continents?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		continents, _, err := client.Continents(context.Background(), 1, []string{})
+		continents, _, err := client.Continents(context.Background(), 1, nil)
 
 		if continents != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", continents)
@@ -142,17 +196,29 @@ func TestContinents(t *testing.T) {
 
 		assertError(t, err)
 	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		url := defaultBaseURL + "/core/continents?api_token=api-key&page=1"
+
+		server := mockResponseServer(t, continentsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.Continents(context.Background(), 1, nil)
+
+		assertResponseDetails(t, details, "Continent")
+	})
 }
 
 func TestContinentByID(t *testing.T) {
 	t.Run("returns a single Continent struct", func(t *testing.T) {
-		url := defaultBaseURL + "/core/continents/1?api_token=api-key&include="
+		url := defaultBaseURL + "/core/continents/1?api_token=api-key"
 
 		server := mockResponseServer(t, continentResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		continent, _, err := client.ContinentByID(context.Background(), 1, []string{})
+		continent, _, err := client.ContinentByID(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -168,7 +234,7 @@ func TestContinentByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		continent, _, err := client.ContinentByID(context.Background(), 1, []string{"countries"})
+		continent, _, err := client.ContinentByID(context.Background(), 1, NewQuery().Include("countries"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -181,13 +247,13 @@ func TestContinentByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/core/continents/1?api_token=api-key&include="
+		url := defaultBaseURL + "/core/continents/1?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		continent, _, err := client.ContinentByID(context.Background(), 1, []string{})
+		continent, _, err := client.ContinentByID(context.Background(), 1, nil)
 
 		if continent != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", continent)
@@ -195,6 +261,18 @@ func TestContinentByID(t *testing.T) {
 
 		assertError(t, err)
 	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		url := defaultBaseURL + "/core/continents/1?api_token=api-key"
+
+		server := mockResponseServer(t, continentResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.ContinentByID(context.Background(), 1, nil)
+
+		assertResponseDetails(t, details, "Continent")
+	})
 }
 
 func assertContinent(t *testing.T, continent *Continent) {
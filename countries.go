@@ -3,9 +3,8 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"strconv"
-	"strings"
 )
 
 // Country provides a struct representation of a Country resource.
@@ -27,59 +26,28 @@ type Country struct {
 
 // Countries fetches Country resources. The endpoint used within this method is paginated, to select the required
 // page use the 'page' method argument. Pagination information including current page and count are included within
-// the Pagination struct with the ResponseDetails struct. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) Countries(ctx context.Context, page int, includes []string) ([]Country, *ResponseDetails, error) {
-	values := url.Values{
-		"page":    {strconv.Itoa(page)},
-		"include": {strings.Join(includes, ";")},
-	}
+// the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Countries(ctx context.Context, page int, query *Query) ([]Country, *ResponseDetails, error) {
+	values := query.Values()
 
-	response := struct {
-		Data         []Country      `json:"data"`
-		Pagination   Pagination     `json:"pagination"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+	values.Set("page", strconv.Itoa(page))
 
-	err := c.getResource(ctx, countriesURI, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	return getMany[Country](ctx, c, countriesURI, values)
+}
 
-	return response.Data, &ResponseDetails{
-		Pagination:   &response.Pagination,
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+// CountriesIter returns an iterator over Country resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) CountriesIter(ctx context.Context, query *Query) iter.Seq2[Country, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Country, *ResponseDetails, error) {
+		return c.Countries(ctx, page, query)
+	})
 }
 
-// CountryByID fetches a Country resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) CountryByID(ctx context.Context, id int, includes []string) (*Country, *ResponseDetails, error) {
+// CountryByID fetches a Country resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) CountryByID(ctx context.Context, id int, query *Query) (*Country, *ResponseDetails, error) {
 	path := fmt.Sprintf(countriesURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data         *Country       `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[Country](ctx, c, path, values)
 }
@@ -237,13 +237,13 @@ var countryIncludesResponse = `{
 
 func TestCountries(t *testing.T) {
 	t.Run("returns Country struct slice", func(t *testing.T) {
-		url := defaultBaseURL + "/core/countries?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/core/countries?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, countriesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		countries, _, err := client.Countries(context.Background(), 1, []string{})
+		countries, _, err := client.Countries(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -261,7 +261,7 @@ func TestCountries(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		countries, _, err := client.Countries(context.Background(), 1, []string{"continent", "leagues"})
+		countries, _, err := client.Countries(context.Background(), 1, NewQuery().Include("continent", "leagues"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -276,13 +276,13 @@ func TestCountries(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/core/countries?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/core/countries?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		countries, _, err := client.Countries(context.Background(), 1, []string{})
+		countries, _, err := client.Countries(context.Background(), 1, nil)
 
 		if countries != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", countries)
@@ -298,7 +298,7 @@ func TestCountries(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.Countries(context.Background(), 1, []string{"continent", "leagues"})
+		_, details, _ := client.Countries(context.Background(), 1, NewQuery().Include("continent", "leagues"))
 
 		assertResponseDetails(t, details, "Country")
 	})
@@ -306,13 +306,13 @@ func TestCountries(t *testing.T) {
 
 func TestCountryByID(t *testing.T) {
 	t.Run("returns a single Country struct", func(t *testing.T) {
-		url := defaultBaseURL + "/core/countries/1?api_token=api-key&include="
+		url := defaultBaseURL + "/core/countries/1?api_token=api-key"
 
 		server := mockResponseServer(t, countryResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		country, _, err := client.CountryByID(context.Background(), 1, []string{})
+		country, _, err := client.CountryByID(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -330,7 +330,7 @@ func TestCountryByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		country, _, err := client.CountryByID(context.Background(), 11, []string{"continent", "leagues"})
+		country, _, err := client.CountryByID(context.Background(), 11, NewQuery().Include("continent", "leagues"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -351,7 +351,7 @@ func TestCountryByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		country, _, err := client.CountryByID(context.Background(), 11, []string{"continent", "leagues"})
+		country, _, err := client.CountryByID(context.Background(), 11, NewQuery().Include("continent", "leagues"))
 
 		if country != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", country)
@@ -367,7 +367,7 @@ func TestCountryByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.CountryByID(context.Background(), 11, []string{"continent", "leagues"})
+		_, details, _ := client.CountryByID(context.Background(), 11, NewQuery().Include("continent", "leagues"))
 
 		assertResponseDetails(t, details, "Country")
 	})
@@ -399,9 +399,9 @@ func assertResponseDetails(t *testing.T, d *ResponseDetails, entity string) {
 
 	s := d.Subscription[0]
 
-	assert.Nil(t, s.Meta[0].TrialEndsAt)
-	assert.Equal(t, "2024-10-26 12:06:34", s.Meta[0].EndsAt)
-	assert.Equal(t, int64(1728372666), s.Meta[0].CurrentTimestamp)
+	assert.Nil(t, s.Meta.TrialEndsAt)
+	assert.Equal(t, "2024-10-26 12:06:34", s.Meta.EndsAt)
+	assert.Equal(t, int64(1728372666), s.Meta.CurrentTimestamp)
 
 	assert.Equal(t, 1, len(s.Plans))
 	assert.Equal(t, "Joe Sweeny Custom Plan", s.Plans[0].Plan)
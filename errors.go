@@ -1,23 +1,234 @@
 package sportmonks
 
-import "fmt"
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
 
-// ErrBadStatusCode is returned when the API returns a non 200 error code.
+// maxSnippetLength is the maximum number of bytes of a response body captured within an error.
+const maxSnippetLength = 512
+
+// ErrBadStatusCode is returned when the API returns a non 200 error code. More specific errors returned for 401, 403,
+// 404, 422, 429 and 5xx status codes all unwrap to an ErrBadStatusCode, so it can be used to inspect the status code,
+// path and response body of any failed request.
 type ErrBadStatusCode struct {
 	Message string `json:"message"`
+	// StatusCode is the HTTP status code returned by the API.
+	StatusCode int `json:"-"`
+	// Path is the requested endpoint including query parameters, with the API token redacted.
+	Path string `json:"-"`
+	// Snippet holds the start of the response body.
+	Snippet string `json:"-"`
 }
 
 func (e *ErrBadStatusCode) Error() string {
 	return fmt.Sprintf("Request failed with the message: %s", e.Message)
 }
 
+// Retryable reports whether the request may succeed if sent again.
+func (e *ErrBadStatusCode) Retryable() bool {
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// Is reports whether target is an ErrBadStatusCode with the same status code, or with a zero status code matching
+// any status code.
+func (e *ErrBadStatusCode) Is(target error) bool {
+	t, ok := target.(*ErrBadStatusCode)
+	return ok && (t.StatusCode == 0 || t.StatusCode == e.StatusCode)
+}
+
+// ErrUnauthorized is returned when the API returns a 401 or 403 error code, typically because the API token is
+// invalid or the subscription plan does not include the requested resource.
+type ErrUnauthorized struct {
+	*ErrBadStatusCode
+}
+
+func (e *ErrUnauthorized) Unwrap() error {
+	return e.ErrBadStatusCode
+}
+
+func (e *ErrUnauthorized) Is(target error) bool {
+	_, ok := target.(*ErrUnauthorized)
+	return ok
+}
+
+// ErrNotFound is returned when the API returns a 404 error code.
+type ErrNotFound struct {
+	*ErrBadStatusCode
+}
+
+func (e *ErrNotFound) Unwrap() error {
+	return e.ErrBadStatusCode
+}
+
+func (e *ErrNotFound) Is(target error) bool {
+	_, ok := target.(*ErrNotFound)
+	return ok
+}
+
+// ErrValidation is returned when the API returns a 422 error code because request parameters such as includes or
+// filters are invalid.
+type ErrValidation struct {
+	*ErrBadStatusCode
+}
+
+func (e *ErrValidation) Unwrap() error {
+	return e.ErrBadStatusCode
+}
+
+func (e *ErrValidation) Is(target error) bool {
+	_, ok := target.(*ErrValidation)
+	return ok
+}
+
+// ErrServer is returned when the API returns a 5xx error code.
+type ErrServer struct {
+	*ErrBadStatusCode
+}
+
+func (e *ErrServer) Unwrap() error {
+	return e.ErrBadStatusCode
+}
+
+func (e *ErrServer) Is(target error) bool {
+	_, ok := target.(*ErrServer)
+	return ok
+}
+
 // ErrRateLimit is returned when the API returns a 429 error code.
 type ErrRateLimit struct {
-	Message   string `json:"message"`
-	Link      string `json:"link"`
-	ResetCode string `json:"reset_code"`
+	Message   string     `json:"message"`
+	Link      string     `json:"link"`
+	ResetCode string     `json:"reset_code"`
+	RateLimit *RateLimit `json:"rate_limit"`
+	// StatusCode is the HTTP status code returned by the API.
+	StatusCode int `json:"-"`
+	// Path is the requested endpoint including query parameters, with the API token redacted.
+	Path string `json:"-"`
+	// Snippet holds the start of the response body.
+	Snippet string `json:"-"`
 }
 
 func (e *ErrRateLimit) Error() string {
 	return fmt.Sprintf("Request failed with the message: '%s', link: '%s', reset code: '%s'", e.Message, e.Link, e.ResetCode)
 }
+
+// Retryable reports whether the request may succeed if sent again, which is always the case once the rate limit
+// resets.
+func (e *ErrRateLimit) Retryable() bool {
+	return true
+}
+
+func (e *ErrRateLimit) Unwrap() error {
+	return &ErrBadStatusCode{Message: e.Message, StatusCode: e.StatusCode, Path: e.Path, Snippet: e.Snippet}
+}
+
+func (e *ErrRateLimit) Is(target error) bool {
+	_, ok := target.(*ErrRateLimit)
+	return ok
+}
+
+// ErrDecode is returned when a response body cannot be decoded.
+type ErrDecode struct {
+	// StatusCode is the HTTP status code returned by the API.
+	StatusCode int
+	// Path is the requested endpoint including query parameters, with the API token redacted.
+	Path string
+	// Snippet holds the start of the response body.
+	Snippet string
+	// Err is the underlying decoding error.
+	Err error
+}
+
+func (e *ErrDecode) Error() string {
+	return fmt.Sprintf("Failed to decode response from '%s': %s", e.Path, e.Err)
+}
+
+// Retryable reports whether the request may succeed if sent again.
+func (e *ErrDecode) Retryable() bool {
+	return false
+}
+
+func (e *ErrDecode) Unwrap() error {
+	return e.Err
+}
+
+// ErrQuotaExhausted is returned when the client holds back a request because the remaining API quota for the
+// requested entity is exhausted and will not reset in time.
+type ErrQuotaExhausted struct {
+	Entity   string
+	ResetsAt time.Time
+}
+
+func (e *ErrQuotaExhausted) Error() string {
+	return fmt.Sprintf("Request not sent, quota for entity '%s' is exhausted until %s", e.Entity, e.ResetsAt.Format(time.RFC3339))
+}
+
+// newStatusError builds the error returned for a non 200 response. Bodies that are not JSON, or do not contain a
+// message, result in an error carrying the HTTP status text as its message.
+func newStatusError(status int, path string, body []byte) error {
+	snippet := snippet(body)
+
+	if status == http.StatusTooManyRequests {
+		err := &ErrRateLimit{}
+
+		if json.Unmarshal(body, err) != nil || err.Message == "" {
+			err.Message = http.StatusText(status)
+		}
+
+		err.StatusCode = status
+		err.Path = path
+		err.Snippet = snippet
+
+		return err
+	}
+
+	err := &ErrBadStatusCode{}
+
+	if json.Unmarshal(body, err) != nil || err.Message == "" {
+		err.Message = http.StatusText(status)
+	}
+
+	err.StatusCode = status
+	err.Path = path
+	err.Snippet = snippet
+
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return &ErrUnauthorized{err}
+	case status == http.StatusNotFound:
+		return &ErrNotFound{err}
+	case status == http.StatusUnprocessableEntity:
+		return &ErrValidation{err}
+	case status >= http.StatusInternalServerError:
+		return &ErrServer{err}
+	}
+
+	return err
+}
+
+// redactedPath returns the path and query of a request URL with the API token redacted.
+func redactedPath(u *url.URL) string {
+	query := u.Query()
+
+	if query.Has("api_token") {
+		query.Set("api_token", "REDACTED")
+	}
+
+	if len(query) == 0 {
+		return u.Path
+	}
+
+	return u.Path + "?" + query.Encode()
+}
+
+func snippet(body []byte) string {
+	if len(body) > maxSnippetLength {
+		body = body[:maxSnippetLength]
+	}
+
+	return string(body)
+}
@@ -0,0 +1,116 @@
+package sportmonks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusErrors(t *testing.T) {
+	statuses := []struct {
+		name      string
+		status    int
+		target    error
+		retryable bool
+	}{
+		{"unauthorized", http.StatusUnauthorized, &ErrUnauthorized{}, false},
+		{"forbidden", http.StatusForbidden, &ErrUnauthorized{}, false},
+		{"not found", http.StatusNotFound, &ErrNotFound{}, false},
+		{"validation", http.StatusUnprocessableEntity, &ErrValidation{}, false},
+		{"server", http.StatusBadGateway, &ErrServer{}, true},
+		{"rate limit", http.StatusTooManyRequests, &ErrRateLimit{}, true},
+	}
+
+	for _, s := range statuses {
+		t.Run("returns "+s.name+" error for status code", func(t *testing.T) {
+			calls := 0
+
+			client := newTestHTTPClient(sequenceServer(&calls, stringResponse(s.status, errorResponse)))
+
+			_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+			assert.True(t, errors.Is(err, s.target))
+
+			var statusErr *ErrBadStatusCode
+
+			assert.True(t, errors.As(err, &statusErr))
+			assert.Equal(t, s.status, statusErr.StatusCode)
+			assert.Equal(t, "/v3/football/coaches/2?api_token=REDACTED", statusErr.Path)
+			assert.Equal(t, errorResponse, statusErr.Snippet)
+			assert.Equal(t, s.retryable, statusErr.Retryable())
+			assert.True(t, errors.Is(err, &ErrBadStatusCode{StatusCode: s.status}))
+			assert.False(t, errors.Is(err, &ErrBadStatusCode{StatusCode: http.StatusTeapot}))
+		})
+	}
+
+	t.Run("returns errors that do not match other error types", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(sequenceServer(&calls, stringResponse(http.StatusNotFound, errorResponse)))
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		assert.False(t, errors.Is(err, &ErrServer{}))
+		assert.False(t, errors.Is(err, &ErrRateLimit{}))
+		assertError(t, err)
+	})
+
+	t.Run("uses status text as message for a body that is not json", func(t *testing.T) {
+		calls := 0
+
+		body := "<html><body>502 Bad Gateway</body></html>"
+
+		client := newTestHTTPClient(sequenceServer(&calls, stringResponse(http.StatusBadGateway, body)))
+
+		_, _, err := client.CoachByID(context.Background(), 2, NewQuery().Include("teams"))
+
+		var serverErr *ErrServer
+
+		assert.True(t, errors.As(err, &serverErr))
+		assert.Equal(t, "Request failed with the message: Bad Gateway", err.Error())
+		assert.Equal(t, "/v3/football/coaches/2?api_token=REDACTED&include=teams", serverErr.Path)
+		assert.Equal(t, body, serverErr.Snippet)
+	})
+
+	t.Run("truncates long response bodies", func(t *testing.T) {
+		calls := 0
+
+		body := strings.Repeat("x", 1000)
+
+		client := newTestHTTPClient(sequenceServer(&calls, stringResponse(http.StatusInternalServerError, body)))
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		var statusErr *ErrBadStatusCode
+
+		assert.True(t, errors.As(err, &statusErr))
+		assert.Equal(t, maxSnippetLength, len(statusErr.Snippet))
+	})
+}
+
+func TestDecodeError(t *testing.T) {
+	t.Run("returns decode error for a malformed response body", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(sequenceServer(&calls, stringResponse(http.StatusOK, `{"data": {"id": "abc"}}`)))
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		var decodeErr *ErrDecode
+
+		assert.True(t, errors.As(err, &decodeErr))
+		assert.Equal(t, http.StatusOK, decodeErr.StatusCode)
+		assert.Equal(t, "/v3/football/coaches/2?api_token=REDACTED", decodeErr.Path)
+		assert.Equal(t, `{"data": {"id": "abc"}}`, decodeErr.Snippet)
+		assert.False(t, decodeErr.Retryable())
+
+		var typeErr *json.UnmarshalTypeError
+
+		assert.True(t, errors.As(err, &typeErr))
+	})
+}
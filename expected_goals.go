@@ -0,0 +1,299 @@
+package sportmonks
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"strconv"
+)
+
+const defaultMatchLength = 90
+
+// ExpectedGoals provides a struct representation of an expected goals record of a team or of a player within a
+// fixture. Team records are returned by the 'xGFixture' include of a Fixture and by ExpectedGoalsByTeam, player
+// records by the 'lineups.xGLineup' include and by ExpectedGoalsByPlayer.
+type ExpectedGoals struct {
+	ID            int               `json:"id"`
+	FixtureID     int               `json:"fixture_id"`
+	TypeID        int               `json:"type_id"`
+	ParticipantID int               `json:"participant_id"`
+	Location      string            `json:"location"`
+	PlayerID      int               `json:"player_id"`
+	TeamID        int               `json:"team_id"`
+	LineupID      int               `json:"lineup_id"`
+	Data          ExpectedGoalsData `json:"data"`
+}
+
+// ExpectedGoalsData holds the value of an ExpectedGoals record.
+type ExpectedGoalsData struct {
+	Value float64 `json:"value"`
+}
+
+// Team returns the ID of the team the record belongs to, being the ParticipantID of team records and the TeamID of
+// player records.
+func (x *ExpectedGoals) Team() int {
+	if x.ParticipantID != 0 {
+		return x.ParticipantID
+	}
+
+	return x.TeamID
+}
+
+// ExpectedGoalsByTeam fetches the ExpectedGoals records of teams. The endpoint used within this method is paginated,
+// to select the required page use the 'page' method argument. Pagination information including current page and count
+// are included within the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) ExpectedGoalsByTeam(ctx context.Context, page int, query *Query) ([]ExpectedGoals, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[ExpectedGoals](ctx, c, expectedTeamsURI, values)
+}
+
+// ExpectedGoalsByTeamIter returns an iterator over the ExpectedGoals records of teams that transparently requests each
+// page of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ExpectedGoalsByTeamIter(ctx context.Context, query *Query) iter.Seq2[ExpectedGoals, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]ExpectedGoals, *ResponseDetails, error) {
+		return c.ExpectedGoalsByTeam(ctx, page, query)
+	})
+}
+
+// ExpectedGoalsByPlayer fetches the ExpectedGoals records of players. The endpoint used within this method is
+// paginated, to select the required page use the 'page' method argument. Pagination information including current
+// page and count are included within the Pagination struct with the ResponseDetails struct. Use the query to enrich
+// and filter the response data.
+func (c *HTTPClient) ExpectedGoalsByPlayer(ctx context.Context, page int, query *Query) ([]ExpectedGoals, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[ExpectedGoals](ctx, c, expectedPlayersURI, values)
+}
+
+// ExpectedGoalsByPlayerIter returns an iterator over the ExpectedGoals records of players that transparently requests
+// each page of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ExpectedGoalsByPlayerIter(ctx context.Context, query *Query) iter.Seq2[ExpectedGoals, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]ExpectedGoals, *ResponseDetails, error) {
+		return c.ExpectedGoalsByPlayer(ctx, page, query)
+	})
+}
+
+// XGTotals totals the expected goals for (XG) and against (XGA) of a team or player across one or more fixtures.
+type XGTotals struct {
+	XG       float64
+	XGA      float64
+	Fixtures int
+	// Minutes is the number of minutes played, only populated for players.
+	Minutes int
+}
+
+// Difference returns the expected goal difference, XG minus XGA.
+func (t XGTotals) Difference() float64 {
+	return t.XG - t.XGA
+}
+
+func (t XGTotals) add(o XGTotals) XGTotals {
+	return XGTotals{XG: t.XG + o.XG, XGA: t.XGA + o.XGA, Fixtures: t.Fixtures + o.Fixtures, Minutes: t.Minutes + o.Minutes}
+}
+
+// FixtureXG holds the expected goal totals of each team and player of a fixture, keyed by team and player ID.
+type FixtureXG struct {
+	FixtureID int
+	Teams     map[int]XGTotals
+	Players   map[int]XGTotals
+}
+
+// FixtureExpectedGoals totals the expected goals of a fixture requested with the 'xGFixture', 'lineups.xGLineup' and
+// 'events' includes. Team xG is taken from the fixture records, or summed from the player records when the fixture
+// has none, and the XGA of a team is the xG of its opponent. Each player that took part receives the XGA of their team
+// apportioned by the share of the match they were on the pitch, determined from the starting lineup and the
+// substitution and sending off events.
+func FixtureExpectedGoals(f *Fixture) FixtureXG {
+	teams := map[int]float64{}
+	var order []int
+
+	addTeam := func(id int) {
+		if id != 0 && !slices.Contains(order, id) {
+			order = append(order, id)
+		}
+	}
+
+	for _, t := range f.Participants {
+		addTeam(t.ID)
+	}
+
+	// Records of other types, such as expected goals on target, are ignored.
+	for _, x := range f.ExpectedGoals {
+		if StatTypeID(x.TypeID) == StatExpectedGoals {
+			teams[x.Team()] += x.Data.Value
+			addTeam(x.Team())
+		}
+	}
+
+	teamRecords := len(teams) > 0
+	players := map[int]float64{}
+
+	for _, l := range f.Lineups {
+		addTeam(l.TeamID)
+
+		for _, x := range l.ExpectedGoals {
+			if StatTypeID(x.TypeID) != StatExpectedGoals {
+				continue
+			}
+
+			players[l.PlayerID] += x.Data.Value
+
+			if !teamRecords {
+				teams[l.TeamID] += x.Data.Value
+			}
+		}
+	}
+
+	result := FixtureXG{FixtureID: f.ID, Teams: map[int]XGTotals{}, Players: map[int]XGTotals{}}
+
+	for _, id := range order {
+		var against float64
+
+		for _, opponent := range order {
+			if opponent != id {
+				against += teams[opponent]
+			}
+		}
+
+		result.Teams[id] = XGTotals{XG: teams[id], XGA: against, Fixtures: 1}
+	}
+
+	length := f.Length
+
+	if length <= 0 {
+		length = defaultMatchLength
+	}
+
+	minutes := minutesPlayed(f, length)
+
+	for _, l := range f.Lineups {
+		m := minutes[l.PlayerID]
+
+		if m == 0 && players[l.PlayerID] == 0 {
+			continue
+		}
+
+		result.Players[l.PlayerID] = XGTotals{
+			XG:       players[l.PlayerID],
+			XGA:      result.Teams[l.TeamID].XGA * float64(m) / float64(length),
+			Fixtures: 1,
+			Minutes:  m,
+		}
+	}
+
+	return result
+}
+
+// minutesPlayed returns the minutes each player of the fixture was on the pitch.
+func minutesPlayed(f *Fixture, length int) map[int]int {
+	on := map[int]int{}
+	off := map[int]int{}
+
+	for _, l := range f.Lineups {
+		if LineupTypeID(l.TypeID) == LineupTypeLineup {
+			on[l.PlayerID] = 0
+		}
+	}
+
+	for _, e := range f.Events {
+		minute := min(max(e.Minute, 0), length)
+
+		switch EventTypeID(e.TypeID) {
+		case EventSubstitution:
+			if e.PlayerID != nil {
+				on[*e.PlayerID] = minute
+			}
+
+			if e.RelatedPlayerID != nil {
+				off[*e.RelatedPlayerID] = minute
+			}
+		case EventRedCard, EventYellowRedCard:
+			if e.PlayerID != nil {
+				off[*e.PlayerID] = minute
+			}
+		}
+	}
+
+	minutes := make(map[int]int, len(on))
+
+	for id, start := range on {
+		end, ok := off[id]
+
+		if !ok {
+			end = length
+		}
+
+		minutes[id] = max(end-start, 0)
+	}
+
+	return minutes
+}
+
+// SeasonExpectedGoals totals the FixtureExpectedGoals of each fixture, e.g. the fixtures of a season, keyed by team
+// and player ID.
+func SeasonExpectedGoals(fixtures []Fixture) (teams, players map[int]XGTotals) {
+	teams, players = map[int]XGTotals{}, map[int]XGTotals{}
+
+	for i := range fixtures {
+		xg := FixtureExpectedGoals(&fixtures[i])
+
+		for id, t := range xg.Teams {
+			teams[id] = teams[id].add(t)
+		}
+
+		for id, p := range xg.Players {
+			players[id] = players[id].add(p)
+		}
+	}
+
+	return teams, players
+}
+
+// RollingExpectedGoals returns the expected goal totals of a team over its most recent fixtures, as of each fixture
+// the team took part in ordered by kickoff. A window of zero or less totals every fixture up to and including each
+// fixture. The result holds an entry for each fixture of the team, keyed by fixture ID.
+func RollingExpectedGoals(fixtures []Fixture, teamID, window int) map[int]XGTotals {
+	sorted := slices.Clone(fixtures)
+
+	slices.SortStableFunc(sorted, func(a, b Fixture) int {
+		return a.Kickoff().Compare(b.Kickoff())
+	})
+
+	var played []XGTotals
+	var fixtureIDs []int
+
+	for i := range sorted {
+		t, ok := FixtureExpectedGoals(&sorted[i]).Teams[teamID]
+
+		if ok {
+			played = append(played, t)
+			fixtureIDs = append(fixtureIDs, sorted[i].ID)
+		}
+	}
+
+	rolling := make(map[int]XGTotals, len(played))
+
+	for i, id := range fixtureIDs {
+		start := 0
+
+		if window > 0 {
+			start = max(i+1-window, 0)
+		}
+
+		var total XGTotals
+
+		for _, t := range played[start : i+1] {
+			total = total.add(t)
+		}
+
+		rolling[id] = total
+	}
+
+	return rolling
+}
@@ -0,0 +1,296 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var expectedTeamsResponse = `{
+	"data": [
+		{
+			"id": 15,
+			"fixture_id": 19134492,
+			"type_id": 5304,
+			"participant_id": 1,
+			"data": {
+				"value": 1.7345
+			},
+			"location": "home"
+		}
+	],
+	"pagination": {
+		"count": 1,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "ExpectedTeam"
+	},
+	"timezone": "UTC"
+}`
+
+var expectedPlayersResponse = `{
+	"data": [
+		{
+			"id": 16,
+			"fixture_id": 19134492,
+			"player_id": 37,
+			"team_id": 1,
+			"lineup_id": 9001,
+			"type_id": 5304,
+			"data": {
+				"value": 0.4321
+			}
+		}
+	],
+	"timezone": "UTC"
+}`
+
+var fixtureExpectedGoalsResponse = `{
+	"data": {
+		"id": 19134492,
+		"length": 90,
+		"xgfixture": [
+			{"id": 15, "fixture_id": 19134492, "type_id": 5304, "participant_id": 1, "data": {"value": 1.7345}, "location": "home"}
+		],
+		"lineups": [
+			{
+				"id": 9001,
+				"player_id": 37,
+				"team_id": 1,
+				"type_id": 11,
+				"xglineup": [
+					{"id": 16, "fixture_id": 19134492, "player_id": 37, "team_id": 1, "lineup_id": 9001, "type_id": 5304, "data": {"value": 0.4321}}
+				]
+			}
+		]
+	}
+}`
+
+func TestExpectedGoalsByTeam(t *testing.T) {
+	url := defaultBaseURL + "/football/expected/fixtures?api_token=api-key&page=1"
+
+	t.Run("returns ExpectedGoals struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, expectedTeamsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		xg, details, err := client.ExpectedGoalsByTeam(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 15, xg[0].ID)
+		assert.Equal(t, 19134492, xg[0].FixtureID)
+		assert.Equal(t, 5304, xg[0].TypeID)
+		assert.Equal(t, 1, xg[0].ParticipantID)
+		assert.Equal(t, 1, xg[0].Team())
+		assert.Equal(t, "home", xg[0].Location)
+		assert.Equal(t, 1.7345, xg[0].Data.Value)
+		assert.Equal(t, "ExpectedTeam", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		xg, _, err := client.ExpectedGoalsByTeam(context.Background(), 1, nil)
+
+		if xg != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", xg)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestExpectedGoalsByPlayer(t *testing.T) {
+	url := defaultBaseURL + "/football/expected/lineups?api_token=api-key&page=1"
+
+	t.Run("returns ExpectedGoals struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, expectedPlayersResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		xg, _, err := client.ExpectedGoalsByPlayer(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 16, xg[0].ID)
+		assert.Equal(t, 37, xg[0].PlayerID)
+		assert.Equal(t, 1, xg[0].TeamID)
+		assert.Equal(t, 1, xg[0].Team())
+		assert.Equal(t, 9001, xg[0].LineupID)
+		assert.Equal(t, 0.4321, xg[0].Data.Value)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		xg, _, err := client.ExpectedGoalsByPlayer(context.Background(), 1, nil)
+
+		if xg != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", xg)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestFixture_ExpectedGoalsInclude(t *testing.T) {
+	t.Run("decodes the team and player expected goals includes", func(t *testing.T) {
+		url := defaultBaseURL + "/football/fixtures/19134492?api_token=api-key&include=xGFixture%3Blineups.xGLineup"
+
+		server := mockResponseServer(t, fixtureExpectedGoalsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, NewQuery().Include("xGFixture", "lineups.xGLineup"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1.7345, fixture.ExpectedGoals[0].Data.Value)
+		assert.Equal(t, 0.4321, fixture.Lineups[0].ExpectedGoals[0].Data.Value)
+	})
+}
+
+func xgRecord(teamID int, value float64) ExpectedGoals {
+	return ExpectedGoals{TypeID: 5304, ParticipantID: teamID, Data: ExpectedGoalsData{Value: value}}
+}
+
+func xgLineup(playerID, teamID, typeID int, values ...float64) LineupPlayer {
+	l := LineupPlayer{PlayerID: playerID, TeamID: teamID, TypeID: typeID}
+
+	for _, v := range values {
+		l.ExpectedGoals = append(l.ExpectedGoals, ExpectedGoals{TypeID: 5304, PlayerID: playerID, TeamID: teamID, Data: ExpectedGoalsData{Value: v}})
+	}
+
+	return l
+}
+
+func xgFixture(id int, day int, home, away float64) Fixture {
+	return Fixture{
+		ID:            id,
+		StartingAt:    NewTime(time.Date(2024, 8, day, 15, 0, 0, 0, time.UTC)),
+		Participants:  []Team{{ID: 1}, {ID: 2}},
+		ExpectedGoals: []ExpectedGoals{xgRecord(1, home), xgRecord(2, away)},
+	}
+}
+
+func TestFixtureExpectedGoals(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	fixture := Fixture{
+		ID:           10,
+		Length:       90,
+		Participants: []Team{{ID: 1}, {ID: 2}},
+		ExpectedGoals: []ExpectedGoals{
+			xgRecord(1, 1.8),
+			xgRecord(2, 0.9),
+			{TypeID: 5305, ParticipantID: 1, Data: ExpectedGoalsData{Value: 1.2}},
+		},
+		Lineups: []LineupPlayer{
+			xgLineup(100, 1, 11, 0.5, 0.3),
+			xgLineup(101, 1, 11),
+			xgLineup(102, 1, 12, 0.2),
+			xgLineup(103, 1, 12),
+			xgLineup(200, 2, 11, 0.9),
+			xgLineup(201, 2, 11),
+		},
+		Events: []FixtureEvent{
+			{TypeID: 18, Minute: 60, PlayerID: intPtr(102), RelatedPlayerID: intPtr(101)},
+			{TypeID: 20, Minute: 45, PlayerID: intPtr(201)},
+		},
+	}
+
+	t.Run("totals the team expected goals", func(t *testing.T) {
+		xg := FixtureExpectedGoals(&fixture)
+
+		assert.Equal(t, 10, xg.FixtureID)
+		assert.Equal(t, XGTotals{XG: 1.8, XGA: 0.9, Fixtures: 1}, xg.Teams[1])
+		assert.Equal(t, XGTotals{XG: 0.9, XGA: 1.8, Fixtures: 1}, xg.Teams[2])
+		assert.InDelta(t, 0.9, xg.Teams[1].Difference(), 1e-9)
+	})
+
+	t.Run("apportions the team xga by minutes played", func(t *testing.T) {
+		xg := FixtureExpectedGoals(&fixture)
+
+		assert.Equal(t, 5, len(xg.Players))
+		assert.InDelta(t, 0.8, xg.Players[100].XG, 1e-9)
+		assert.InDelta(t, 0.9, xg.Players[100].XGA, 1e-9)
+		assert.Equal(t, 90, xg.Players[100].Minutes)
+
+		assert.Equal(t, 60, xg.Players[101].Minutes)
+		assert.InDelta(t, 0.6, xg.Players[101].XGA, 1e-9)
+
+		assert.Equal(t, 30, xg.Players[102].Minutes)
+		assert.InDelta(t, 0.3, xg.Players[102].XGA, 1e-9)
+
+		assert.Equal(t, 45, xg.Players[201].Minutes)
+
+		_, ok := xg.Players[103]
+
+		assert.False(t, ok)
+	})
+
+	t.Run("sums the player records when the fixture has no team records", func(t *testing.T) {
+		f := fixture
+		f.ExpectedGoals = nil
+		f.Participants = nil
+		f.Length = 0
+
+		xg := FixtureExpectedGoals(&f)
+
+		assert.InDelta(t, 1.0, xg.Teams[1].XG, 1e-9)
+		assert.InDelta(t, 0.9, xg.Teams[1].XGA, 1e-9)
+		assert.InDelta(t, 0.9, xg.Teams[2].XG, 1e-9)
+		assert.Equal(t, 90, xg.Players[100].Minutes)
+	})
+}
+
+func TestSeasonExpectedGoals(t *testing.T) {
+	fixtures := []Fixture{
+		xgFixture(3, 24, 0.5, 2.0),
+		xgFixture(1, 10, 1.5, 1.0),
+		xgFixture(2, 17, 2.5, 0.5),
+	}
+
+	t.Run("totals every fixture", func(t *testing.T) {
+		teams, players := SeasonExpectedGoals(fixtures)
+
+		assert.Equal(t, 3, teams[1].Fixtures)
+		assert.InDelta(t, 4.5, teams[1].XG, 1e-9)
+		assert.InDelta(t, 3.5, teams[1].XGA, 1e-9)
+		assert.InDelta(t, -1.0, teams[2].Difference(), 1e-9)
+		assert.Empty(t, players)
+	})
+
+	t.Run("totals a rolling window of fixtures in kickoff order", func(t *testing.T) {
+		rolling := RollingExpectedGoals(fixtures, 1, 2)
+
+		assert.Equal(t, 3, len(rolling))
+		assert.Equal(t, XGTotals{XG: 1.5, XGA: 1.0, Fixtures: 1}, rolling[1])
+		assert.Equal(t, XGTotals{XG: 4.0, XGA: 1.5, Fixtures: 2}, rolling[2])
+		assert.Equal(t, XGTotals{XG: 3.0, XGA: 2.5, Fixtures: 2}, rolling[3])
+
+		cumulative := RollingExpectedGoals(fixtures, 1, 0)
+
+		assert.Equal(t, 3, cumulative[3].Fixtures)
+		assert.InDelta(t, 4.5, cumulative[3].XG, 1e-9)
+	})
+}
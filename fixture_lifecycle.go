@@ -0,0 +1,163 @@
+package sportmonks
+
+import "fmt"
+
+// FixturePhase is a stage in the lifecycle of a fixture, grouping the states a fixture moves through. A fixture moves
+// from not started through its live periods and the breaks between them, to an outcome of finished, postponed,
+// cancelled, abandoned or awarded.
+type FixturePhase int
+
+const (
+	PhaseUnknown FixturePhase = iota
+	PhaseNotStarted
+	PhaseLive
+	PhaseBreak
+	PhaseInterrupted
+	PhaseFinished
+	PhasePostponed
+	PhaseCancelled
+	PhaseAbandoned
+	PhaseAwarded
+)
+
+var phaseNames = map[FixturePhase]string{
+	PhaseUnknown:     "unknown",
+	PhaseNotStarted:  "not_started",
+	PhaseLive:        "live",
+	PhaseBreak:       "break",
+	PhaseInterrupted: "interrupted",
+	PhaseFinished:    "finished",
+	PhasePostponed:   "postponed",
+	PhaseCancelled:   "cancelled",
+	PhaseAbandoned:   "abandoned",
+	PhaseAwarded:     "awarded",
+}
+
+func (p FixturePhase) String() string {
+	if name, ok := phaseNames[p]; ok {
+		return name
+	}
+
+	return phaseNames[PhaseUnknown]
+}
+
+// IsTerminal reports whether a fixture in the phase has reached an outcome.
+func (p FixturePhase) IsTerminal() bool {
+	switch p {
+	case PhaseFinished, PhaseCancelled, PhaseAbandoned, PhaseAwarded:
+		return true
+	}
+
+	return false
+}
+
+var statePhases = map[StateID]FixturePhase{
+	StateNS:              PhaseNotStarted,
+	StateTBA:             PhaseNotStarted,
+	StateDelayed:         PhaseNotStarted,
+	StateInplay1stHalf:   PhaseLive,
+	StateInplay2ndHalf:   PhaseLive,
+	StateInplayET:        PhaseLive,
+	StateInplayET2ndHalf: PhaseLive,
+	StateInplayPenalties: PhaseLive,
+	StateHT:              PhaseBreak,
+	StateBreak:           PhaseBreak,
+	StateExtraTimeBreak:  PhaseBreak,
+	StatePenBreak:        PhaseBreak,
+	StateSuspended:       PhaseInterrupted,
+	StateInterrupted:     PhaseInterrupted,
+	StateFT:              PhaseFinished,
+	StateAET:             PhaseFinished,
+	StateFTPen:           PhaseFinished,
+	StatePostponed:       PhasePostponed,
+	StateCancelled:       PhaseCancelled,
+	StateAbandoned:       PhaseAbandoned,
+	StateAwarded:         PhaseAwarded,
+	StateWO:              PhaseAwarded,
+}
+
+// progress orders the states of a fixture being played. A finished state is ordered with the last state it can be
+// reached from, e.g. a fixture cannot finish after regular time once extra time has started.
+var progress = map[StateID]int{
+	StateNS:              0,
+	StateTBA:             0,
+	StateDelayed:         0,
+	StateInplay1stHalf:   1,
+	StateHT:              2,
+	StateInplay2ndHalf:   3,
+	StateBreak:           4,
+	StateFT:              4,
+	StateInplayET:        5,
+	StateExtraTimeBreak:  6,
+	StateInplayET2ndHalf: 7,
+	StateAET:             7,
+	StatePenBreak:        8,
+	StateInplayPenalties: 9,
+	StateFTPen:           9,
+}
+
+// Phase returns the lifecycle phase of a fixture in the state. States that say nothing about the progress of a
+// fixture, such as awaiting updates, and unknown states return PhaseUnknown.
+func (i StateID) Phase() FixturePhase {
+	return statePhases[i]
+}
+
+// Phase returns the lifecycle phase of the fixture.
+func (f *Fixture) Phase() FixturePhase {
+	return StateID(f.StateID).Phase()
+}
+
+// ValidTransition reports whether a fixture can be observed in the state from and later in the state to. States may
+// be skipped between observations, so a transition is valid when to can be reached from from in any number of steps.
+// Transitions to or from a state in PhaseUnknown are always valid.
+func ValidTransition(from, to StateID) bool {
+	if from == to {
+		return true
+	}
+
+	fp, tp := from.Phase(), to.Phase()
+
+	switch {
+	case fp == PhaseUnknown || tp == PhaseUnknown:
+		return true
+	case fp.IsTerminal():
+		// A result may be awarded after the outcome of a fixture, e.g. following a protest.
+		return tp == PhaseAwarded && fp != PhaseAwarded
+	case fp == PhaseInterrupted || fp == PhasePostponed:
+		// Interrupted and postponed fixtures may be resumed, restarted or rescheduled.
+		return true
+	}
+
+	switch tp {
+	case PhaseNotStarted, PhasePostponed, PhaseCancelled:
+		return fp == PhaseNotStarted
+	case PhaseInterrupted, PhaseAbandoned, PhaseAwarded:
+		return true
+	}
+
+	return progress[from] <= progress[to]
+}
+
+// ErrInvalidTransition is returned when a fixture is observed moving between two states that are not possible
+// within its lifecycle, e.g. from full time back to the second half.
+type ErrInvalidTransition struct {
+	FixtureID int
+	From      StateID
+	To        StateID
+}
+
+func (e *ErrInvalidTransition) Error() string {
+	return fmt.Sprintf("Invalid transition of fixture %d from state '%s' to '%s'", e.FixtureID, e.From, e.To)
+}
+
+// ValidateTransition returns an ErrInvalidTransition when next, a later snapshot of the same fixture as prev, is in a
+// state that cannot be reached from the state of prev.
+func ValidateTransition(prev, next Fixture) error {
+	from, to := StateID(prev.StateID), StateID(next.StateID)
+
+	if ValidTransition(from, to) {
+		return nil
+	}
+
+	return &ErrInvalidTransition{FixtureID: next.ID, From: from, To: to}
+}
@@ -0,0 +1,112 @@
+package sportmonks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixturePhase(t *testing.T) {
+	t.Run("classifies the state of a fixture", func(t *testing.T) {
+		tests := map[StateID]FixturePhase{
+			StateNS:              PhaseNotStarted,
+			StateInplay1stHalf:   PhaseLive,
+			StateHT:              PhaseBreak,
+			StatePenBreak:        PhaseBreak,
+			StateSuspended:       PhaseInterrupted,
+			StateFTPen:           PhaseFinished,
+			StatePostponed:       PhasePostponed,
+			StateCancelled:       PhaseCancelled,
+			StateAbandoned:       PhaseAbandoned,
+			StateWO:              PhaseAwarded,
+			StateAwaitingUpdates: PhaseUnknown,
+			StateID(999):         PhaseUnknown,
+		}
+
+		for state, phase := range tests {
+			fixture := Fixture{StateID: int(state)}
+
+			assert.Equal(t, phase, fixture.Phase(), state.String())
+		}
+	})
+
+	t.Run("reports terminal phases", func(t *testing.T) {
+		assert.True(t, PhaseFinished.IsTerminal())
+		assert.True(t, PhaseAwarded.IsTerminal())
+		assert.False(t, PhasePostponed.IsTerminal())
+		assert.False(t, PhaseBreak.IsTerminal())
+	})
+
+	t.Run("returns the name of the phase", func(t *testing.T) {
+		assert.Equal(t, "not_started", PhaseNotStarted.String())
+		assert.Equal(t, "unknown", FixturePhase(99).String())
+	})
+}
+
+func TestValidTransition(t *testing.T) {
+	t.Run("allows the lifecycle of a fixture", func(t *testing.T) {
+		lifecycle := []StateID{
+			StateNS, StateInplay1stHalf, StateHT, StateInplay2ndHalf, StateBreak, StateInplayET, StateExtraTimeBreak,
+			StateInplayET2ndHalf, StatePenBreak, StateInplayPenalties, StateFTPen,
+		}
+
+		for i := 1; i < len(lifecycle); i++ {
+			assert.True(t, ValidTransition(lifecycle[i-1], lifecycle[i]), "%s to %s", lifecycle[i-1], lifecycle[i])
+		}
+	})
+
+	t.Run("allows states to be skipped between observations", func(t *testing.T) {
+		assert.True(t, ValidTransition(StateNS, StateFT))
+		assert.True(t, ValidTransition(StateInplay1stHalf, StateInplay2ndHalf))
+		assert.True(t, ValidTransition(StateHT, StateAET))
+		assert.True(t, ValidTransition(StateNS, StateAbandoned))
+	})
+
+	t.Run("allows interrupted and postponed fixtures to resume", func(t *testing.T) {
+		assert.True(t, ValidTransition(StateInplay2ndHalf, StateSuspended))
+		assert.True(t, ValidTransition(StateSuspended, StateInplay2ndHalf))
+		assert.True(t, ValidTransition(StateNS, StatePostponed))
+		assert.True(t, ValidTransition(StatePostponed, StateNS))
+	})
+
+	t.Run("allows a result to be awarded after an outcome", func(t *testing.T) {
+		assert.True(t, ValidTransition(StateFT, StateAwarded))
+		assert.True(t, ValidTransition(StateAbandoned, StateAwarded))
+		assert.False(t, ValidTransition(StateAwarded, StateWO))
+	})
+
+	t.Run("rejects impossible transitions", func(t *testing.T) {
+		assert.False(t, ValidTransition(StateInplay2ndHalf, StateInplay1stHalf))
+		assert.False(t, ValidTransition(StateInplay1stHalf, StateNS))
+		assert.False(t, ValidTransition(StateFT, StateInplay2ndHalf))
+		assert.False(t, ValidTransition(StateInplayET, StateFT))
+		assert.False(t, ValidTransition(StatePenBreak, StateAET))
+		assert.False(t, ValidTransition(StateInplay1stHalf, StatePostponed))
+		assert.False(t, ValidTransition(StateCancelled, StateNS))
+	})
+
+	t.Run("allows transitions involving unknown states", func(t *testing.T) {
+		assert.True(t, ValidTransition(StateInplay2ndHalf, StateAwaitingUpdates))
+		assert.True(t, ValidTransition(StateAwaitingUpdates, StateInplay1stHalf))
+		assert.True(t, ValidTransition(StateID(0), StateFT))
+	})
+}
+
+func TestValidateTransition(t *testing.T) {
+	t.Run("returns nil for a valid transition", func(t *testing.T) {
+		prev := Fixture{ID: 10, StateID: int(StateHT)}
+		next := Fixture{ID: 10, StateID: int(StateInplay2ndHalf)}
+
+		assert.Nil(t, ValidateTransition(prev, next))
+	})
+
+	t.Run("returns an ErrInvalidTransition for an impossible transition", func(t *testing.T) {
+		prev := Fixture{ID: 10, StateID: int(StateFT)}
+		next := Fixture{ID: 10, StateID: int(StateHT)}
+
+		err := ValidateTransition(prev, next)
+
+		assert.Equal(t, &ErrInvalidTransition{FixtureID: 10, From: StateFT, To: StateHT}, err)
+		assert.EqualError(t, err, "Invalid transition of fixture 10 from state 'Full Time' to 'Half-Time'")
+	})
+}
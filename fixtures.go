@@ -3,7 +3,7 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"strconv"
 	"strings"
 	"time"
@@ -12,144 +12,153 @@ import (
 const dateFormat = "2006-01-02"
 
 type Fixture struct {
-	ID                  int            `json:"id"`
-	SportID             int            `json:"sport_id"`
-	LeagueID            int            `json:"league_id"`
-	SeasonID            int            `json:"season_id"`
-	StageID             int            `json:"stage_id"`
-	GroupID             *int           `json:"group_id"`
-	AggregateID         *int           `json:"aggregate_id"`
-	RoundID             int            `json:"round_id"`
-	StateID             int            `json:"state_id"`
-	VenueID             *int           `json:"venue_id"`
-	Name                string         `json:"name"`
-	StartingAt          string         `json:"starting_at"`
-	ResultInfo          string         `json:"result_info"`
-	Leg                 string         `json:"leg"`
-	Details             *string        `json:"details"`
-	Length              int            `json:"length"`
-	Placeholder         bool           `json:"placeholder"`
-	HasOdds             bool           `json:"has_odds"`
-	HasPremiumOdds      bool           `json:"has_premium_odds"`
-	StartingAtTimestamp int64          `json:"starting_at_timestamp"`
-	Round               *Round         `json:"round,omitempty"`
-	Stage               *Stage         `json:"stage,omitempty"`
-	League              *League        `json:"league,omitempty"`
-	Season              *Season        `json:"season,omitempty"`
-	Coaches             []Coach        `json:"coaches,omitempty"`
-	Venues              *Venue         `json:"venues,omitempty"`
-	FixtureState        *FixtureState  `json:"state,omitempty"`
-	WeatherReport       *WeatherReport `json:"weatherReport,omitempty"`
-	Lineups             []LineupPlayer `json:"lineups,omitempty"`
-	Events              []FixtureEvent `json:"events,omitempty"`
-	Statistics          []FixtureStat  `json:"statistics,omitempty"`
-	Scores              []Score        `json:"scores,omitempty"`
-	Formations          []Formation    `json:"formations,omitempty"`
-	Participants        []Team         `json:"participants,omitempty"`
+	ID                  int             `json:"id"`
+	SportID             int             `json:"sport_id"`
+	LeagueID            int             `json:"league_id"`
+	SeasonID            int             `json:"season_id"`
+	StageID             int             `json:"stage_id"`
+	GroupID             *int            `json:"group_id"`
+	AggregateID         *int            `json:"aggregate_id"`
+	RoundID             int             `json:"round_id"`
+	StateID             int             `json:"state_id"`
+	VenueID             *int            `json:"venue_id"`
+	Name                string          `json:"name"`
+	StartingAt          Time            `json:"starting_at"`
+	ResultInfo          string          `json:"result_info"`
+	Leg                 string          `json:"leg"`
+	Details             *string         `json:"details"`
+	Length              int             `json:"length"`
+	Placeholder         bool            `json:"placeholder"`
+	HasOdds             bool            `json:"has_odds"`
+	HasPremiumOdds      bool            `json:"has_premium_odds"`
+	StartingAtTimestamp int64           `json:"starting_at_timestamp"`
+	Round               *Round          `json:"round,omitempty"`
+	Stage               *Stage          `json:"stage,omitempty"`
+	League              *League         `json:"league,omitempty"`
+	Season              *Season         `json:"season,omitempty"`
+	Coaches             []Coach         `json:"coaches,omitempty"`
+	Venues              *Venue          `json:"venues,omitempty"`
+	FixtureState        *FixtureState   `json:"state,omitempty"`
+	WeatherReport       *WeatherReport  `json:"weatherReport,omitempty"`
+	Lineups             []LineupPlayer  `json:"lineups,omitempty"`
+	Events              []FixtureEvent  `json:"events,omitempty"`
+	Statistics          []FixtureStat   `json:"statistics,omitempty"`
+	Scores              []Score         `json:"scores,omitempty"`
+	Formations          []Formation     `json:"formations,omitempty"`
+	Periods             []Period        `json:"periods,omitempty"`
+	Participants        []Team          `json:"participants,omitempty"`
+	ExpectedGoals       []ExpectedGoals `json:"xgfixture,omitempty"`
 }
 
-// FixtureByID fetches a Fixture resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) FixtureByID(ctx context.Context, id int, includes []string, filters map[string][]int) (*Fixture, *ResponseDetails, error) {
-	path := fmt.Sprintf(fixturesURI+"/%d", id)
-
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
+// Kickoff returns the time the fixture starts, using StartingAtTimestamp when StartingAt is not set.
+func (f *Fixture) Kickoff() time.Time {
+	if !f.StartingAt.IsZero() || f.StartingAtTimestamp == 0 {
+		return f.StartingAt.Time
 	}
 
-	formatFilters(&values, filters)
-
-	response := struct {
-		Data         *Fixture       `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+	return time.Unix(f.StartingAtTimestamp, 0).UTC()
+}
 
-	err := c.getResource(ctx, path, values, &response)
+// FixtureByID fetches a Fixture resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixtureByID(ctx context.Context, id int, query *Query) (*Fixture, *ResponseDetails, error) {
+	path := fmt.Sprintf(fixturesURI+"/%d", id)
 
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[Fixture](ctx, c, path, values)
 }
 
-// FixturesByID fetches multiple Fixture resources by their IDS. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) FixturesByID(ctx context.Context, ids []int, includes []string, filters map[string][]int, page int) ([]Fixture, *ResponseDetails, error) {
+// FixturesByID fetches multiple Fixture resources by their IDS. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesByID(ctx context.Context, ids []int, query *Query, page int) ([]Fixture, *ResponseDetails, error) {
 	str := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ","), "[]")
 
 	path := fmt.Sprintf(fixturesMultiURI+"/%s", str)
 
-	return multipleFixtureResponse(ctx, c, path, includes, filters, page)
+	return multipleFixtureResponse(ctx, c, path, query, page)
+}
+
+// FixturesByIDIter returns an iterator over Fixture resources for the given IDs that transparently requests each page
+// of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesByIDIter(ctx context.Context, ids []int, query *Query) iter.Seq2[Fixture, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Fixture, *ResponseDetails, error) {
+		return c.FixturesByID(ctx, ids, query, page)
+	})
 }
 
-// FixturesByDate fetches multiple Fixture resources for a given date. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) FixturesByDate(ctx context.Context, date time.Time, includes []string, filters map[string][]int, page int) ([]Fixture, *ResponseDetails, error) {
+// FixturesByDate fetches multiple Fixture resources for a given date. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesByDate(ctx context.Context, date time.Time, query *Query, page int) ([]Fixture, *ResponseDetails, error) {
 	path := fmt.Sprintf(fixturesDateURI + "/" + date.Format(dateFormat))
 
-	return multipleFixtureResponse(ctx, c, path, includes, filters, page)
+	return multipleFixtureResponse(ctx, c, path, query, page)
+}
+
+// FixturesByDateIter returns an iterator over Fixture resources for a given date that transparently requests each page
+// of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesByDateIter(ctx context.Context, date time.Time, query *Query) iter.Seq2[Fixture, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Fixture, *ResponseDetails, error) {
+		return c.FixturesByDate(ctx, date, query, page)
+	})
 }
 
-// FixturesBetween fetches multiple Fixture resources for between two dates. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) FixturesBetween(ctx context.Context, from, to time.Time, includes []string, filters map[string][]int, page int) ([]Fixture, *ResponseDetails, error) {
+// FixturesBetween fetches multiple Fixture resources for between two dates. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesBetween(ctx context.Context, from, to time.Time, query *Query, page int) ([]Fixture, *ResponseDetails, error) {
 	path := fmt.Sprintf(fixturesBetweenURI+"/%s/%s", from.Format(dateFormat), to.Format(dateFormat))
 
-	return multipleFixtureResponse(ctx, c, path, includes, filters, page)
+	return multipleFixtureResponse(ctx, c, path, query, page)
 }
 
-// FixturesBetweenForTeam fetches multiple Fixture resources for between two dates for a given team ID. Use the includes slice of string
-// to enrich the response data.
-func (c *HTTPClient) FixturesBetweenForTeam(ctx context.Context, from, to time.Time, page, teamID int, includes []string, filters map[string][]int) ([]Fixture, *ResponseDetails, error) {
+// FixturesBetweenIter returns an iterator over Fixture resources between two dates that transparently requests each
+// page of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) FixturesBetweenIter(ctx context.Context, from, to time.Time, query *Query) iter.Seq2[Fixture, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Fixture, *ResponseDetails, error) {
+		return c.FixturesBetween(ctx, from, to, query, page)
+	})
+}
+
+// FixturesBetweenForTeam fetches multiple Fixture resources for between two dates for a given team ID. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) FixturesBetweenForTeam(ctx context.Context, from, to time.Time, page, teamID int, query *Query) ([]Fixture, *ResponseDetails, error) {
 	path := fmt.Sprintf(fixturesBetweenURI+"/%s/%s/%d", from.Format(dateFormat), to.Format(dateFormat), teamID)
 
-	return multipleFixtureResponse(ctx, c, path, includes, filters, page)
+	return multipleFixtureResponse(ctx, c, path, query, page)
+}
+
+// FixturesBetweenForTeamIter returns an iterator over Fixture resources between two dates for a given team ID that
+// transparently requests each page of the paginated endpoint in turn. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) FixturesBetweenForTeamIter(ctx context.Context, from, to time.Time, teamID int, query *Query) iter.Seq2[Fixture, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Fixture, *ResponseDetails, error) {
+		return c.FixturesBetweenForTeam(ctx, from, to, page, teamID, query)
+	})
 }
 
-// HeadToHead fetches multiple Fixture resources of results between two teams. Use the includes slice of string to enrich
+// HeadToHead fetches multiple Fixture resources of results between two teams. Use the query to enrich and filter
 // the response data.
-func (c *HTTPClient) HeadToHead(ctx context.Context, idOne, idTwo int, includes []string, page int) ([]Fixture, *ResponseDetails, error) {
+func (c *HTTPClient) HeadToHead(ctx context.Context, idOne, idTwo int, query *Query, page int) ([]Fixture, *ResponseDetails, error) {
 	path := fmt.Sprintf(headToHeadURI+"/%d/%d", idOne, idTwo)
 
-	return multipleFixtureResponse(ctx, c, path, includes, map[string][]int{}, page)
+	return multipleFixtureResponse(ctx, c, path, query, page)
 }
 
-func (c *HTTPClient) LatestUpdatedFixtures(ctx context.Context, includes []string, filters map[string][]int) ([]Fixture, *ResponseDetails, error) {
-	path := fixturesLatestURI
-	
-	return multipleFixtureResponse(ctx, c, path, includes, filters, 1)
+// HeadToHeadIter returns an iterator over Fixture resources of results between two teams that transparently requests
+// each page of the paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) HeadToHeadIter(ctx context.Context, idOne, idTwo int, query *Query) iter.Seq2[Fixture, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Fixture, *ResponseDetails, error) {
+		return c.HeadToHead(ctx, idOne, idTwo, query, page)
+	})
 }
 
-func multipleFixtureResponse(ctx context.Context, client *HTTPClient, path string, includes []string, filters map[string][]int, page int) ([]Fixture, *ResponseDetails, error) {
-	
-	values := url.Values{
-		"page":    {strconv.Itoa(page)},
-		"include": {strings.Join(includes, ";")},
-	}
+func (c *HTTPClient) LatestUpdatedFixtures(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error) {
+	path := fixturesLatestURI
 
-	formatFilters(&values, filters)
+	return multipleFixtureResponse(ctx, c, path, query, 1)
+}
 
-	response := struct {
-		Data         []Fixture      `json:"data"`
-		Pagination   *Pagination    `json:"pagination"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+func multipleFixtureResponse(ctx context.Context, client *HTTPClient, path string, query *Query, page int) ([]Fixture, *ResponseDetails, error) {
 
-	err := client.getResource(ctx, path, values, &response)
+	values := query.Values()
 
-	if err != nil {
-		return nil, nil, err
-	}
+	values.Set("page", strconv.Itoa(page))
 
-	return response.Data, &ResponseDetails{
-		Pagination:   response.Pagination,
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getMany[Fixture](ctx, client, path, values)
 }
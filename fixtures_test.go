@@ -155,13 +155,13 @@ var fixturesResponse = `{
 
 func TestFixtureByID(t *testing.T) {
 	t.Run("returns a single Fixture struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key"
 
 		server := mockResponseServer(t, fixtureResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		fixture, _, err := client.FixtureByID(context.Background(), 11867285, []string{}, map[string][]int{})
+		fixture, _, err := client.FixtureByID(context.Background(), 11867285, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -180,8 +180,7 @@ func TestFixtureByID(t *testing.T) {
 		fixture, _, err := client.FixtureByID(
 			context.Background(),
 			11867285,
-			[]string{"round", "scores"},
-			map[string][]int{},
+			NewQuery().Include("round", "scores"),
 		)
 
 		if err != nil {
@@ -194,7 +193,7 @@ func TestFixtureByID(t *testing.T) {
 	})
 
 	t.Run("returns a single Fixture struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key&include=round%3Bstage%3Bgoals&leagues=8%2C10"
+		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key&filters=leagues%3A8%2C10&include=round%3Bstage%3Bgoals"
 
 		server := mockResponseServer(t, fixtureResponse, 200, url)
 
@@ -203,13 +202,7 @@ func TestFixtureByID(t *testing.T) {
 		fixture, _, err := client.FixtureByID(
 			context.Background(),
 			11867285,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{
-				"leagues": {
-					8,
-					10,
-				},
-			},
+			NewQuery().Include("round", "stage", "goals").Filter("leagues", 8, 10),
 		)
 
 		if err != nil {
@@ -221,13 +214,13 @@ func TestFixtureByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		fixture, _, err := client.FixtureByID(context.Background(), 11867285, []string{}, map[string][]int{})
+		fixture, _, err := client.FixtureByID(context.Background(), 11867285, nil)
 
 		if fixture != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", fixture)
@@ -237,13 +230,13 @@ func TestFixtureByID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/11867285?api_token=api-key"
 
 		server := mockResponseServer(t, fixtureResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.FixtureByID(context.Background(), 11867285, []string{}, map[string][]int{})
+		_, details, _ := client.FixtureByID(context.Background(), 11867285, nil)
 
 		assertResponseDetails(t, details, "Fixture")
 	})
@@ -251,7 +244,7 @@ func TestFixtureByID(t *testing.T) {
 
 func TestFixturesByID(t *testing.T) {
 	t.Run("returns slice of Fixture struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -260,8 +253,7 @@ func TestFixturesByID(t *testing.T) {
 		fixtures, _, err := client.FixturesByID(
 			context.Background(),
 			[]int{11867285, 555},
-			[]string{},
-			map[string][]int{},
+			nil,
 			1,
 		)
 
@@ -273,7 +265,7 @@ func TestFixturesByID(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include=round%3Bstage%3Bgoals"
+		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -282,8 +274,7 @@ func TestFixturesByID(t *testing.T) {
 		fixtures, _, err := client.FixturesByID(
 			context.Background(),
 			[]int{11867285, 555},
-			[]string{"round", "stage", "goals"},
-			map[string][]int{},
+			NewQuery().Include("round", "stage", "goals"),
 			1,
 		)
 
@@ -296,7 +287,7 @@ func TestFixturesByID(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include=round%3Bstage%3Bgoals&leagues=8%2C10"
+		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&filters=leagues%3A8%2C10&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -305,13 +296,7 @@ func TestFixturesByID(t *testing.T) {
 		fixtures, _, err := client.FixturesByID(
 			context.Background(),
 			[]int{11867285, 555},
-			[]string{"round", "stage", "goals"},
-			map[string][]int{
-				"leagues": {
-					8,
-					10,
-				},
-			},
+			NewQuery().Include("round", "stage", "goals").Filter("leagues", 8, 10),
 			1,
 		)
 
@@ -324,13 +309,13 @@ func TestFixturesByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		fixtures, _, err := client.FixturesByID(context.Background(), []int{11867285, 555}, []string{}, map[string][]int{},1)
+		fixtures, _, err := client.FixturesByID(context.Background(), []int{11867285, 555}, nil, 1)
 
 		if fixtures != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", fixtures)
@@ -340,7 +325,7 @@ func TestFixturesByID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/multi/11867285,555?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -349,8 +334,7 @@ func TestFixturesByID(t *testing.T) {
 		_, details, _ := client.FixturesByID(
 			context.Background(),
 			[]int{11867285, 555},
-			[]string{},
-			map[string][]int{},
+			nil,
 			1,
 		)
 
@@ -367,13 +351,13 @@ func TestFixturesByDate(t *testing.T) {
 	}
 
 	t.Run("returns slice of Fixture struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		fixtures, _, err := client.FixturesByDate(context.Background(), d, []string{}, map[string][]int{},1)
+		fixtures, _, err := client.FixturesByDate(context.Background(), d, nil, 1)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -383,7 +367,7 @@ func TestFixturesByDate(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include=round%3Bstage%3Bgoals"
+		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -392,8 +376,7 @@ func TestFixturesByDate(t *testing.T) {
 		fixtures, _, err := client.FixturesByDate(
 			context.Background(),
 			d,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{},
+			NewQuery().Include("round", "stage", "goals"),
 			1,
 		)
 
@@ -406,7 +389,7 @@ func TestFixturesByDate(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include=round%3Bstage%3Bgoals&markets=8%2C10"
+		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&filters=markets%3A8%2C10&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -415,13 +398,7 @@ func TestFixturesByDate(t *testing.T) {
 		fixtures, _, err := client.FixturesByDate(
 			context.Background(),
 			d,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{
-				"markets": {
-					8,
-					10,
-				},
-			},
+			NewQuery().Include("round", "stage", "goals").Filter("markets", 8, 10),
 			1,
 		)
 
@@ -434,13 +411,13 @@ func TestFixturesByDate(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		fixtures, _, err := client.FixturesByDate(context.Background(), d, []string{}, map[string][]int{},1)
+		fixtures, _, err := client.FixturesByDate(context.Background(), d, nil, 1)
 
 		if fixtures != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", fixtures)
@@ -450,13 +427,13 @@ func TestFixturesByDate(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/date/2014-11-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.FixturesByDate(context.Background(), d, []string{}, map[string][]int{},1)
+		_, details, _ := client.FixturesByDate(context.Background(), d, nil, 1)
 
 		assertResponseDetails(t, details, "Fixture")
 	})
@@ -476,13 +453,13 @@ func TestFixturesBetween(t *testing.T) {
 	}
 
 	t.Run("returns slice of Fixture struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		fixtures, _, err := client.FixturesBetween(context.Background(), dateFrom, dateTo, []string{}, map[string][]int{},1)
+		fixtures, _, err := client.FixturesBetween(context.Background(), dateFrom, dateTo, nil, 1)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -492,7 +469,7 @@ func TestFixturesBetween(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include=round%3Bstage%3Bgoals"
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -502,8 +479,7 @@ func TestFixturesBetween(t *testing.T) {
 			context.Background(),
 			dateFrom,
 			dateTo,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{},
+			NewQuery().Include("round", "stage", "goals"),
 			1,
 		)
 
@@ -516,7 +492,7 @@ func TestFixturesBetween(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include=round%3Bstage%3Bgoals&leagues=8%2C10"
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&filters=leagues%3A8%2C10&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -526,13 +502,7 @@ func TestFixturesBetween(t *testing.T) {
 			context.Background(),
 			dateFrom,
 			dateTo,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{
-				"leagues": {
-					8,
-					10,
-				},
-			},
+			NewQuery().Include("round", "stage", "goals").Filter("leagues", 8, 10),
 			1,
 		)
 
@@ -545,13 +515,13 @@ func TestFixturesBetween(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		fixtures, _, err := client.FixturesBetween(context.Background(), dateFrom, dateTo, []string{}, map[string][]int{},1,)
+		fixtures, _, err := client.FixturesBetween(context.Background(), dateFrom, dateTo, nil, 1)
 
 		if fixtures != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", fixtures)
@@ -561,13 +531,13 @@ func TestFixturesBetween(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.FixturesBetween(context.Background(), dateFrom, dateTo, []string{}, map[string][]int{},1,)
+		_, details, _ := client.FixturesBetween(context.Background(), dateFrom, dateTo, nil, 1)
 
 		assertResponseDetails(t, details, "Fixture")
 	})
@@ -587,7 +557,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 	}
 
 	t.Run("returns slice of Fixture struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -599,8 +569,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 			dateTo,
 			1,
 			1,
-			[]string{},
-			map[string][]int{},
+			nil,
 		)
 
 		if err != nil {
@@ -611,7 +580,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&include=round%3Bstage%3Bgoals"
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&include=round%3Bstage%3Bgoals&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -623,8 +592,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 			dateTo,
 			1,
 			1,
-			[]string{"round", "stage", "goals"},
-			map[string][]int{},
+			NewQuery().Include("round", "stage", "goals"),
 		)
 
 		if err != nil {
@@ -636,7 +604,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 	})
 
 	t.Run("returns slice of Fixture struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&include=round%3Bstage%3Bgoals%3Aorder%28starting_at%7Casc%29&leagues=8%2C10"
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&filters=leagues%3A8%2C10&include=round%3Bstage%3Bgoals%3Aorder%28starting_at%7Casc%29&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
@@ -648,13 +616,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 			dateTo,
 			1,
 			1,
-			[]string{"round", "stage", "goals:order(starting_at|asc)"},
-			map[string][]int{
-				"leagues": {
-					8,
-					10,
-				},
-			},
+			NewQuery().Include("round", "stage").IncludeSort("goals", "starting_at", Asc).Filter("leagues", 8, 10),
 		)
 
 		if err != nil {
@@ -666,7 +628,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12/1?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
@@ -678,8 +640,7 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 			dateTo,
 			1,
 			1,
-			[]string{},
-			map[string][]int{},
+			nil,
 		)
 
 		if fixtures != nil {
@@ -690,13 +651,13 @@ func TestFixturesBetweenForTeam(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&include="
+		url := defaultBaseURL + "/football/fixtures/between/2014-11-12/2014-12-12?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, fixturesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.FixturesBetween(context.Background(), dateFrom, dateTo, []string{}, map[string][]int{},1,)
+		_, details, _ := client.FixturesBetween(context.Background(), dateFrom, dateTo, nil, 1)
 
 		assertResponseDetails(t, details, "Fixture")
 	})
@@ -714,7 +675,7 @@ func assertFixture(t *testing.T, fixture *Fixture) {
 	assert.Equal(t, 5, fixture.StateID)
 	assert.Nil(t, fixture.VenueID)
 	assert.Equal(t, "Tottenham Hotspur vs Manchester City", fixture.Name)
-	assert.Equal(t, "2010-08-14 11:45:00", fixture.StartingAt)
+	assert.Equal(t, "2010-08-14 11:45:00", fixture.StartingAt.String())
 	assert.Equal(t, "Game ended in draw.", fixture.ResultInfo)
 	assert.Equal(t, "1/1", fixture.Leg)
 	assert.Nil(t, fixture.Details)
@@ -1,60 +1,94 @@
 package sportmonks
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"strings"
+	"time"
 )
 
 const (
-	defaultBaseURL             = "https://api.sportmonks.com/v3"
-	coachesURI                 = "/football/coaches"
-	commentariesFixtureURI     = "/commentaries/fixture"
-	continentsURI              = "/core/continents"
-	countriesURI               = "/core/countries"
-	fixturesURI                = "/football/fixtures"
-	fixturesDateURI            = "/football/fixtures/date"
-	fixturesBetweenURI         = "/football/fixtures/between"
-	fixturesMultiURI           = "/football/fixtures/multi"
-	headToHeadURI              = "/football/fixtures/head-to-head"
-	leaguesURI                 = "/football/leagues"
-	playersURI                 = "/football/players"
-	roundsURI                  = "/football/rounds"
-	roundsSeasonURI            = "/football/rounds/seasons"
-	seasonsURI                 = "/football/seasons"
-	stagesURI                  = "/football/stages"
-	stagesSeasonURI            = "/football/stages/seasons"
-	teamSquadURI               = "/football/squads/teams"
-	teamSeasonSquadURI         = "/football/squads/seasons"
-	teamsURI                   = "/football/teams"
-	teamsSeasonURI             = "/football/teams/seasons"
-	topScorersSeasonURI        = "/football/topscorers/seasons"
-	tvStationsURI              = "/football/tv-stations/fixtures"
-	venuesURI                  = "/football/venues"
-	venuesSeasonURI            = "/football/venues/seasons"
-	fixturesLatestURI          = "/football/fixtures/latest"
-	prematchOddsURI            = "/football/odds/pre-match"
-	prematchOddsURIByFixtureID = "/football/odds/pre-match/fixtures"
-	lastUpdatedOddsURI         = "/football/odds/pre-match/latest"
+	defaultBaseURL               = "https://api.sportmonks.com/v3"
+	coachesURI                   = "/football/coaches"
+	commentariesFixtureURI       = "/commentaries/fixture"
+	continentsURI                = "/core/continents"
+	countriesURI                 = "/core/countries"
+	fixturesURI                  = "/football/fixtures"
+	fixturesDateURI              = "/football/fixtures/date"
+	fixturesBetweenURI           = "/football/fixtures/between"
+	fixturesMultiURI             = "/football/fixtures/multi"
+	headToHeadURI                = "/football/fixtures/head-to-head"
+	leaguesURI                   = "/football/leagues"
+	livescoresURI                = "/football/livescores"
+	livescoresInplayURI          = "/football/livescores/inplay"
+	livescoresLatestURI          = "/football/livescores/latest"
+	playersURI                   = "/football/players"
+	roundsURI                    = "/football/rounds"
+	roundsSeasonURI              = "/football/rounds/seasons"
+	seasonsURI                   = "/football/seasons"
+	stagesURI                    = "/football/stages"
+	stagesSeasonURI              = "/football/stages/seasons"
+	standingsSeasonURI           = "/football/standings/seasons"
+	standingsRoundURI            = "/football/standings/rounds"
+	standingsLiveLeagueURI       = "/football/standings/live/leagues"
+	standingCorrectionsSeasonURI = "/football/standings/corrections/seasons"
+	statesURI                    = "/football/states"
+	teamSquadURI                 = "/football/squads/teams"
+	teamSeasonSquadURI           = "/football/squads/seasons"
+	teamsURI                     = "/football/teams"
+	teamsSeasonURI               = "/football/teams/seasons"
+	topScorersSeasonURI          = "/football/topscorers/seasons"
+	tvStationsURI                = "/football/tv-stations/fixtures"
+	venuesURI                    = "/football/venues"
+	venuesSeasonURI              = "/football/venues/seasons"
+	fixturesLatestURI            = "/football/fixtures/latest"
+	prematchOddsURI              = "/football/odds/pre-match"
+	prematchOddsURIByFixtureID   = "/football/odds/pre-match/fixtures"
+	lastUpdatedOddsURI           = "/football/odds/pre-match/latest"
+	inplayOddsURI                = "/football/odds/inplay"
+	inplayOddsFixtureURI         = "/football/odds/inplay/fixtures"
+	latestInplayOddsURI          = "/football/odds/inplay/latest"
+	bookmakersURI                = "/odds/bookmakers"
+	bookmakersFixtureURI         = "/odds/bookmakers/fixtures"
+	bookmakersSearchURI          = "/odds/bookmakers/search"
+	marketsURI                   = "/odds/markets"
+	marketsSearchURI             = "/odds/markets/search"
+	probabilitiesURI             = "/football/predictions/probabilities"
+	probabilitiesFixtureURI      = "/football/predictions/probabilities/fixtures"
+	valueBetsURI                 = "/football/predictions/value-bets"
+	valueBetsFixtureURI          = "/football/predictions/value-bets/fixtures"
+	predictabilityLeagueURI      = "/football/predictions/predictability/leagues"
+	expectedTeamsURI             = "/football/expected/fixtures"
+	expectedPlayersURI           = "/football/expected/lineups"
+	typesURI                     = "/core/types"
+	typesEntitiesURI             = "/core/types/entities"
 )
 
 // HTTPClient is a HTTP request builder and sender.
 type HTTPClient struct {
-	HTTPClient *http.Client
-	BaseURL    string
-	Key        string
+	HTTPClient  *http.Client
+	BaseURL     string
+	Key         string
+	RetryPolicy *RetryPolicy
+	RateLimiter *RateLimiter
+	Cache       Cache
+	CacheTTLs   map[string]time.Duration
+	TimeZone    string
+	Locale      string
+	sleep       func(ctx context.Context, d time.Duration) error
 }
 
 // NewDefaultHTTPClient creates a new Client with default settings. A key is required to instantiate the Client.
 func NewDefaultHTTPClient(key string) *HTTPClient {
 	return &HTTPClient{
-		HTTPClient: &http.Client{},
-		BaseURL:    defaultBaseURL,
-		Key:        key,
+		HTTPClient:  &http.Client{},
+		BaseURL:     defaultBaseURL,
+		Key:         key,
+		RetryPolicy: DefaultRetryPolicy(),
+		RateLimiter: NewRateLimiter(0),
 	}
 }
 
@@ -65,65 +99,193 @@ func (c *HTTPClient) SetHTTPClient(h *http.Client) {
 	}
 }
 
+// SetRetryPolicy provides functionality to override the default RetryPolicy property. A nil policy disables retries.
+func (c *HTTPClient) SetRetryPolicy(p *RetryPolicy) {
+	c.RetryPolicy = p
+}
+
+// SetRateLimiter provides functionality to override the default RateLimiter property. A nil limiter disables
+// client side rate limiting.
+func (c *HTTPClient) SetRateLimiter(r *RateLimiter) {
+	c.RateLimiter = r
+}
+
+// RateLimits returns the most recently known API quota for each requested entity, as tracked by the RateLimiter.
+// No requests are made to retrieve this information.
+func (c *HTTPClient) RateLimits() map[string]Quota {
+	if c.RateLimiter == nil {
+		return map[string]Quota{}
+	}
+
+	return c.RateLimiter.Snapshot()
+}
+
+// SetCache provides functionality to cache API responses. Responses for resource paths matching a key of the ttls map
+// are cached for the associated duration, where the longest matching path prefix wins. A nil cache disables caching.
+func (c *HTTPClient) SetCache(cache Cache, ttls map[string]time.Duration) {
+	c.Cache = cache
+	c.CacheTTLs = ttls
+}
+
+// SetTimeZone provides functionality to set the default time zone of date and time fields in the response data, which
+// can be overridden per request using Query.Timezone.
+func (c *HTTPClient) SetTimeZone(tz string) {
+	c.TimeZone = tz
+}
+
+// SetLocale provides functionality to set the default language of translatable fields in the response data, which can
+// be overridden per request using Query.Locale.
+func (c *HTTPClient) SetLocale(locale string) {
+	c.Locale = locale
+}
+
 // SetBaseURL provides functionality to override the default BaseURL property.
 func (c *HTTPClient) SetBaseURL(url string) {
 	c.BaseURL = url
 }
 
-func (c *HTTPClient) getResource(ctx context.Context, url string, query url.Values, response interface{}) error {
+// getResource fetches the resource at url, decoding the response body into response. The raw response body is
+// returned alongside any error.
+func (c *HTTPClient) getResource(ctx context.Context, url string, query url.Values, response interface{}) ([]byte, error) {
 	req, err := http.NewRequest(http.MethodGet, c.BaseURL+url, nil)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if c.TimeZone != "" && query.Get("timezone") == "" {
+		query.Set("timezone", c.TimeZone)
+	}
+
+	if c.Locale != "" && query.Get("locale") == "" {
+		query.Set("locale", c.Locale)
+	}
+
+	key, ttl := c.cacheEntry(ctx, url, query)
+
+	if ttl > 0 {
+		if cacheRefresh(ctx) {
+			c.Cache.Delete(key)
+		} else if body, ok := c.Cache.Get(key); ok {
+			return body, decodeResponse(url, http.StatusOK, body, response)
+		}
 	}
 
 	query.Set("api_token", c.Key)
 
 	req.URL.RawQuery = query.Encode()
 
-	return c.do(ctx, req, response)
+	body, err := c.do(ctx, url, req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = decodeResponse(redactedPath(req.URL), http.StatusOK, body, response); err != nil {
+		return body, err
+	}
+
+	if ttl > 0 {
+		c.Cache.Set(key, body, ttl)
+	}
+
+	return body, nil
 }
 
-func (c *HTTPClient) do(ctx context.Context, req *http.Request, intf interface{}) error {
-	req = req.WithContext(ctx)
-	resp, err := c.HTTPClient.Do(req)
+func (c *HTTPClient) do(ctx context.Context, path string, req *http.Request) ([]byte, error) {
+	attempts := c.RetryPolicy.attempts()
+
+	for attempt := 1; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(ctx, path); err != nil {
+				return nil, err
+			}
+		}
+
+		status, header, body, err := c.attempt(ctx, path, req)
+
+		if err == nil || attempt >= attempts || ctx.Err() != nil {
+			return body, err
+		}
+
+		wait, retry := c.RetryPolicy.retryDelay(attempt, status, header, err)
+
+		if !retry {
+			return nil, err
+		}
+
+		if c.wait(ctx, wait) != nil {
+			return nil, err
+		}
+	}
+}
+
+// attempt sends a single request, returning the response status code, headers and body alongside any error. A zero
+// status code indicates the request failed before a response was received.
+func (c *HTTPClient) attempt(ctx context.Context, path string, req *http.Request) (int, http.Header, []byte, error) {
+	if c.RetryPolicy != nil && c.RetryPolicy.PerAttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.RetryPolicy.PerAttemptTimeout)
+		defer cancel()
+	}
+
+	resp, err := c.HTTPClient.Do(req.Clone(ctx))
 
 	if err != nil {
-		return err
+		return 0, nil, nil, err
 	}
 
 	defer resp.Body.Close()
 
-	if err = checkStatusCode(resp); err != nil {
-		return err
+	body, err := io.ReadAll(resp.Body)
+
+	if err != nil {
+		return 0, nil, nil, err
 	}
 
-	return parseJSONResponseBody(resp.Body, intf)
+	c.observeRateLimit(path, resp.StatusCode, body)
+
+	if resp.StatusCode != http.StatusOK {
+		return resp.StatusCode, resp.Header, nil, newStatusError(resp.StatusCode, redactedPath(req.URL), body)
+	}
+
+	return resp.StatusCode, resp.Header, body, nil
 }
 
-func checkStatusCode(resp *http.Response) error {
-	if resp.StatusCode == http.StatusTooManyRequests {
-		err := new(ErrRateLimit)
+// observeRateLimit passes the rate_limit block of a response body to the RateLimiter. A 429 response exhausts the
+// quota for the requested entity regardless of what the block reports.
+func (c *HTTPClient) observeRateLimit(path string, status int, body []byte) {
+	if c.RateLimiter == nil {
+		return
+	}
 
-		e := parseJSONResponseBody(resp.Body, &err)
+	response := struct {
+		RateLimit *RateLimit `json:"rate_limit"`
+	}{}
 
-		if e != nil {
-			return e
-		}
+	if json.Unmarshal(body, &response) != nil || response.RateLimit == nil {
+		return
+	}
 
-		return err
+	if status == http.StatusTooManyRequests {
+		response.RateLimit.Remaining = 0
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		err := new(ErrBadStatusCode)
+	c.RateLimiter.Observe(path, *response.RateLimit)
+}
 
-		e := parseJSONResponseBody(resp.Body, &err)
+func (c *HTTPClient) wait(ctx context.Context, d time.Duration) error {
+	if c.sleep != nil {
+		return c.sleep(ctx, d)
+	}
 
-		if e != nil {
-			return e
-		}
+	return sleepContext(ctx, d)
+}
 
-		return err
+// decodeResponse decodes a successful response body, wrapping any failure in an ErrDecode.
+func decodeResponse(path string, status int, body []byte, response interface{}) error {
+	if err := parseJSONResponseBody(io.NopCloser(bytes.NewReader(body)), response); err != nil {
+		return &ErrDecode{StatusCode: status, Path: path, Snippet: snippet(body), Err: err}
 	}
 
 	return nil
@@ -136,13 +298,3 @@ func parseJSONResponseBody(body io.ReadCloser, response interface{}) error {
 
 	return nil
 }
-
-func formatFilters(query *url.Values, filters map[string][]int) {
-	key := "filters"
-	var values []string
-	for k, v := range filters {
-		value := k + ":" + strings.Trim(strings.Join(strings.Fields(fmt.Sprint(v)), ","), "[]")
-		values = append(values, value)
-	}
-	query.Set(key, strings.Join(values, ";"))
-}
@@ -5,8 +5,8 @@ import (
 	"context"
 	"io/ioutil"
 	"net/http"
-	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -27,13 +27,15 @@ func TestNewHTTPClient(t *testing.T) {
 
 		assert.Equal(t, "https://api.sportmonks.com/v3", client.BaseURL)
 		assert.Equal(t, "api-key", client.Key)
+		assert.Equal(t, DefaultRetryPolicy(), client.RetryPolicy)
+		assert.Equal(t, NewRateLimiter(0), client.RateLimiter)
 	})
 
 	t.Run("instantiates with bespoke properties", func(t *testing.T) {
 		client := HTTPClient{
-			&http.Client{},
-			"https://example.com",
-			"new-key",
+			HTTPClient: &http.Client{},
+			BaseURL:    "https://example.com",
+			Key:        "new-key",
 		}
 
 		assert.Equal(t, "https://example.com", client.BaseURL)
@@ -56,7 +58,7 @@ func TestNewHTTPClient(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, _, _ = client.ContinentByID(context.Background(), 10, []string{"countries"})
+		_, _, _ = client.ContinentByID(context.Background(), 10, NewQuery().Include("countries"))
 	})
 
 	t.Run("returns a rate limit error", func(t *testing.T) {
@@ -66,7 +68,7 @@ func TestNewHTTPClient(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, _, err := client.CoachByID(context.Background(), 2)
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
 
 		assert.Equal(
 			t,
@@ -75,52 +77,67 @@ func TestNewHTTPClient(t *testing.T) {
 		)
 	})
 }
-func TestFormatFilters(t *testing.T) {
-	t.Run("formats single filter correctly", func(t *testing.T) {
-		query := &url.Values{}
-		filters := map[string][]int{
-			"team_id": {1, 2, 3},
-		}
 
-		formatFilters(query, filters)
+func TestTimeZoneAndLocale(t *testing.T) {
+	t.Run("client defaults are sent with each request", func(t *testing.T) {
+		url := defaultBaseURL + "/football/fixtures/19134492?api_token=api-key&locale=de&timezone=Europe%2FBerlin"
 
-		expected := "team_id:1,2,3"
-		assert.Equal(t, expected, query.Get("filters"))
-	})
+		server := mockResponseServer(t, `{"data": {"id": 19134492, "starting_at": "2024-08-17 16:00:00"}}`, http.StatusOK, url)
+
+		client := newTestHTTPClient(server)
+		client.SetTimeZone("Europe/Berlin")
+		client.SetLocale("de")
 
-	t.Run("formats multiple filters correctly", func(t *testing.T) {
-		query := &url.Values{}
-		filters := map[string][]int{
-			"team_id":  {1, 2, 3},
-			"player_id": {4, 5, 6},
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
 		}
 
-		formatFilters(query, filters)
+		berlin, _ := time.LoadLocation("Europe/Berlin")
 
-		expected := "team_id:1,2,3;player_id:4,5,6"
-		assert.Equal(t, expected, query.Get("filters"))
+		assert.Equal(t, berlin, fixture.StartingAt.Location())
+		assert.True(t, time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC).Equal(fixture.Kickoff()))
 	})
 
-	t.Run("handles empty filters map", func(t *testing.T) {
-		query := &url.Values{}
-		filters := map[string][]int{}
+	t.Run("query values override client defaults", func(t *testing.T) {
+		url := defaultBaseURL + "/football/fixtures/19134492?api_token=api-key&locale=es&timezone=America%2FNew_York"
+
+		server := mockResponseServer(t, `{"data": {"id": 19134492, "starting_at": "2024-08-17 10:00:00"}, "timezone": "America/New_York"}`, http.StatusOK, url)
+
+		client := newTestHTTPClient(server)
+		client.SetTimeZone("Europe/Berlin")
+		client.SetLocale("de")
+
+		fixture, details, err := client.FixtureByID(context.Background(), 19134492, NewQuery().Timezone("America/New_York").Locale("es"))
 
-		formatFilters(query, filters)
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
 
-		expected := ""
-		assert.Equal(t, expected, query.Get("filters"))
+		assert.Equal(t, "America/New_York", details.TimeZone)
+		assert.Equal(t, "America/New_York", fixture.StartingAt.Location().String())
+		assert.True(t, time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC).Equal(fixture.Kickoff()))
 	})
 
-	t.Run("handles nil filters map", func(t *testing.T) {
-		query := &url.Values{}
-		var filters map[string][]int
+	t.Run("times are parsed in UTC by default", func(t *testing.T) {
+		url := defaultBaseURL + "/football/fixtures/19134492?api_token=api-key"
+
+		server := mockResponseServer(t, `{"data": {"id": 19134492, "starting_at": "2024-08-17 14:00:00"}, "timezone": "UTC"}`, http.StatusOK, url)
+
+		client := newTestHTTPClient(server)
+
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, nil)
 
-		formatFilters(query, filters)
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
 
-		expected := ""
-		assert.Equal(t, expected, query.Get("filters"))
+		assert.Equal(t, time.UTC, fixture.StartingAt.Location())
+		assert.True(t, time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC).Equal(fixture.Kickoff()))
 	})
 }
+
 func assertError(t *testing.T, err error) {
 	assert.Equal(
 		t,
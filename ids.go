@@ -0,0 +1,45 @@
+package sportmonks
+
+//go:generate go run ./internal/idgen -types internal/idgen/catalog/types.json -states internal/idgen/catalog/states.json -out ids_gen.go
+
+// IsLive reports whether a fixture in the state is in play, including the breaks between periods.
+func (i StateID) IsLive() bool {
+	switch i {
+	case StateInplay1stHalf, StateHT, StateBreak, StateInplay2ndHalf, StateInplayET, StateExtraTimeBreak,
+		StateInplayET2ndHalf, StatePenBreak, StateInplayPenalties:
+		return true
+	}
+
+	return false
+}
+
+// IsFinished reports whether a fixture in the state has been played to completion, after regular time, extra time
+// or penalties.
+func (i StateID) IsFinished() bool {
+	switch i {
+	case StateFT, StateAET, StateFTPen:
+		return true
+	}
+
+	return false
+}
+
+// IsPostponed reports whether a fixture in the state has been postponed.
+func (i StateID) IsPostponed() bool {
+	return i == StatePostponed
+}
+
+// IsLive reports whether the fixture is in play, including the breaks between periods.
+func (s *FixtureState) IsLive() bool {
+	return StateID(s.ID).IsLive()
+}
+
+// IsFinished reports whether the fixture has been played to completion.
+func (s *FixtureState) IsFinished() bool {
+	return StateID(s.ID).IsFinished()
+}
+
+// IsPostponed reports whether the fixture has been postponed.
+func (s *FixtureState) IsPostponed() bool {
+	return StateID(s.ID).IsPostponed()
+}
@@ -0,0 +1,305 @@
+// Code generated by idgen from the types and states catalog snapshot. DO NOT EDIT.
+
+package sportmonks
+
+import "strconv"
+
+// EventTypeID identifies the type of a FixtureEvent, e.g. a goal or a substitution.
+type EventTypeID int
+
+const (
+	EventVAR                 EventTypeID = 10 // VAR
+	EventGoal                EventTypeID = 14 // Goal
+	EventOwnGoal             EventTypeID = 15 // Own Goal
+	EventPenalty             EventTypeID = 16 // Penalty
+	EventMissedPenalty       EventTypeID = 17 // Missed Penalty
+	EventSubstitution        EventTypeID = 18 // Substitution
+	EventYellowCard          EventTypeID = 19 // Yellowcard
+	EventRedCard             EventTypeID = 20 // Redcard
+	EventYellowRedCard       EventTypeID = 21 // Yellow/Red card
+	EventPenaltyShootoutMiss EventTypeID = 22 // Penalty Shootout Miss
+	EventPenaltyShootoutGoal EventTypeID = 23 // Penalty Shootout Goal
+)
+
+var eventTypeIDNames = map[EventTypeID]string{
+	EventVAR:                 "VAR",
+	EventGoal:                "Goal",
+	EventOwnGoal:             "Own Goal",
+	EventPenalty:             "Penalty",
+	EventMissedPenalty:       "Missed Penalty",
+	EventSubstitution:        "Substitution",
+	EventYellowCard:          "Yellowcard",
+	EventRedCard:             "Redcard",
+	EventYellowRedCard:       "Yellow/Red card",
+	EventPenaltyShootoutMiss: "Penalty Shootout Miss",
+	EventPenaltyShootoutGoal: "Penalty Shootout Goal",
+}
+
+// String returns the name of the EventTypeID within the catalog, or the ID if it is not known.
+func (i EventTypeID) String() string {
+	if name, ok := eventTypeIDNames[i]; ok {
+		return name
+	}
+
+	return "EventTypeID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// StatTypeID identifies the type of a statistic, e.g. corners or expected goals.
+type StatTypeID int
+
+const (
+	StatCorners               StatTypeID = 34   // Corners
+	StatShotsOffTarget        StatTypeID = 41   // Shots Off Target
+	StatShotsTotal            StatTypeID = 42   // Shots Total
+	StatAttacks               StatTypeID = 43   // Attacks
+	StatDangerousAttacks      StatTypeID = 44   // Dangerous Attacks
+	StatBallPossession        StatTypeID = 45   // Ball Possession %
+	StatPenalties             StatTypeID = 47   // Penalties
+	StatShotsInsideBox        StatTypeID = 49   // Shots Insidebox
+	StatShotsOutsideBox       StatTypeID = 50   // Shots Outsidebox
+	StatOffsides              StatTypeID = 51   // Offsides
+	StatGoals                 StatTypeID = 52   // Goals
+	StatFouls                 StatTypeID = 56   // Fouls
+	StatSaves                 StatTypeID = 57   // Saves
+	StatShotsBlocked          StatTypeID = 58   // Shots Blocked
+	StatTackles               StatTypeID = 78   // Tackles
+	StatAssists               StatTypeID = 79   // Assists
+	StatPasses                StatTypeID = 80   // Passes
+	StatSuccessfulPasses      StatTypeID = 81   // Successful Passes
+	StatRedCards              StatTypeID = 83   // Redcards
+	StatYellowCards           StatTypeID = 84   // Yellowcards
+	StatShotsOnTarget         StatTypeID = 86   // Shots On Target
+	StatRating                StatTypeID = 118  // Rating
+	StatMinutesPlayed         StatTypeID = 119  // Minutes Played
+	StatExpectedGoals         StatTypeID = 5304 // Expected Goals (xG)
+	StatExpectedGoalsOnTarget StatTypeID = 5305 // Expected Goals on Target (xGoT)
+)
+
+var statTypeIDNames = map[StatTypeID]string{
+	StatCorners:               "Corners",
+	StatShotsOffTarget:        "Shots Off Target",
+	StatShotsTotal:            "Shots Total",
+	StatAttacks:               "Attacks",
+	StatDangerousAttacks:      "Dangerous Attacks",
+	StatBallPossession:        "Ball Possession %",
+	StatPenalties:             "Penalties",
+	StatShotsInsideBox:        "Shots Insidebox",
+	StatShotsOutsideBox:       "Shots Outsidebox",
+	StatOffsides:              "Offsides",
+	StatGoals:                 "Goals",
+	StatFouls:                 "Fouls",
+	StatSaves:                 "Saves",
+	StatShotsBlocked:          "Shots Blocked",
+	StatTackles:               "Tackles",
+	StatAssists:               "Assists",
+	StatPasses:                "Passes",
+	StatSuccessfulPasses:      "Successful Passes",
+	StatRedCards:              "Redcards",
+	StatYellowCards:           "Yellowcards",
+	StatShotsOnTarget:         "Shots On Target",
+	StatRating:                "Rating",
+	StatMinutesPlayed:         "Minutes Played",
+	StatExpectedGoals:         "Expected Goals (xG)",
+	StatExpectedGoalsOnTarget: "Expected Goals on Target (xGoT)",
+}
+
+// String returns the name of the StatTypeID within the catalog, or the ID if it is not known.
+func (i StatTypeID) String() string {
+	if name, ok := statTypeIDNames[i]; ok {
+		return name
+	}
+
+	return "StatTypeID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// ScoreTypeID identifies the type of a Score, e.g. the score at half time or the current score.
+type ScoreTypeID int
+
+const (
+	Score1stHalf         ScoreTypeID = 1     // 1st Half
+	Score2ndHalf         ScoreTypeID = 2     // 2nd Half
+	ScoreCurrent         ScoreTypeID = 1525  // Current
+	Score2ndHalfOnly     ScoreTypeID = 48996 // 2nd Half Only
+	ScoreExtraTime       ScoreTypeID = 48997 // Extra Time
+	ScorePenaltyShootout ScoreTypeID = 48998 // Penalty Shootout
+)
+
+var scoreTypeIDNames = map[ScoreTypeID]string{
+	Score1stHalf:         "1st Half",
+	Score2ndHalf:         "2nd Half",
+	ScoreCurrent:         "Current",
+	Score2ndHalfOnly:     "2nd Half Only",
+	ScoreExtraTime:       "Extra Time",
+	ScorePenaltyShootout: "Penalty Shootout",
+}
+
+// String returns the name of the ScoreTypeID within the catalog, or the ID if it is not known.
+func (i ScoreTypeID) String() string {
+	if name, ok := scoreTypeIDNames[i]; ok {
+		return name
+	}
+
+	return "ScoreTypeID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// PositionID identifies the position or detailed position of a player.
+type PositionID int
+
+const (
+	PositionGoalkeeper        PositionID = 24  // Goalkeeper
+	PositionDefender          PositionID = 25  // Defender
+	PositionMidfielder        PositionID = 26  // Midfielder
+	PositionAttacker          PositionID = 27  // Attacker
+	PositionCentreBack        PositionID = 148 // Centre Back
+	PositionDefensiveMidfield PositionID = 149 // Defensive Midfield
+	PositionAttackingMidfield PositionID = 150 // Attacking Midfield
+	PositionCentreForward     PositionID = 151 // Centre Forward
+	PositionLeftWing          PositionID = 152 // Left Wing
+	PositionCentralMidfield   PositionID = 153 // Central Midfield
+	PositionRightBack         PositionID = 154 // Right Back
+	PositionLeftBack          PositionID = 155 // Left Back
+	PositionRightWing         PositionID = 156 // Right Wing
+	PositionLeftMidfield      PositionID = 157 // Left Midfield
+	PositionRightMidfield     PositionID = 158 // Right Midfield
+	PositionSecondaryStriker  PositionID = 163 // Secondary Striker
+)
+
+var positionIDNames = map[PositionID]string{
+	PositionGoalkeeper:        "Goalkeeper",
+	PositionDefender:          "Defender",
+	PositionMidfielder:        "Midfielder",
+	PositionAttacker:          "Attacker",
+	PositionCentreBack:        "Centre Back",
+	PositionDefensiveMidfield: "Defensive Midfield",
+	PositionAttackingMidfield: "Attacking Midfield",
+	PositionCentreForward:     "Centre Forward",
+	PositionLeftWing:          "Left Wing",
+	PositionCentralMidfield:   "Central Midfield",
+	PositionRightBack:         "Right Back",
+	PositionLeftBack:          "Left Back",
+	PositionRightWing:         "Right Wing",
+	PositionLeftMidfield:      "Left Midfield",
+	PositionRightMidfield:     "Right Midfield",
+	PositionSecondaryStriker:  "Secondary Striker",
+}
+
+// String returns the name of the PositionID within the catalog, or the ID if it is not known.
+func (i PositionID) String() string {
+	if name, ok := positionIDNames[i]; ok {
+		return name
+	}
+
+	return "PositionID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// LineupTypeID identifies whether a LineupPlayer started the fixture or was on the bench.
+type LineupTypeID int
+
+const (
+	LineupTypeLineup LineupTypeID = 11 // Lineup
+	LineupTypeBench  LineupTypeID = 12 // Bench
+)
+
+var lineupTypeIDNames = map[LineupTypeID]string{
+	LineupTypeLineup: "Lineup",
+	LineupTypeBench:  "Bench",
+}
+
+// String returns the name of the LineupTypeID within the catalog, or the ID if it is not known.
+func (i LineupTypeID) String() string {
+	if name, ok := lineupTypeIDNames[i]; ok {
+		return name
+	}
+
+	return "LineupTypeID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// PredictionTypeID identifies the type of a Probability, e.g. the fulltime result or both teams to score.
+type PredictionTypeID int
+
+const (
+	PredictionBTTSProbability           PredictionTypeID = 231 // Both Teams To Score Probability
+	PredictionFulltimeResultProbability PredictionTypeID = 237 // Fulltime Result Probability
+)
+
+var predictionTypeIDNames = map[PredictionTypeID]string{
+	PredictionBTTSProbability:           "Both Teams To Score Probability",
+	PredictionFulltimeResultProbability: "Fulltime Result Probability",
+}
+
+// String returns the name of the PredictionTypeID within the catalog, or the ID if it is not known.
+func (i PredictionTypeID) String() string {
+	if name, ok := predictionTypeIDNames[i]; ok {
+		return name
+	}
+
+	return "PredictionTypeID(" + strconv.Itoa(int(i)) + ")"
+}
+
+// StateID identifies the state of a fixture, e.g. not started, in play or finished.
+type StateID int
+
+const (
+	StateNS              StateID = 1  // Not Started
+	StateInplay1stHalf   StateID = 2  // 1st Half
+	StateHT              StateID = 3  // Half-Time
+	StateBreak           StateID = 4  // Regular time finished
+	StateFT              StateID = 5  // Full Time
+	StateInplayET        StateID = 6  // Extra Time
+	StateAET             StateID = 7  // Full Time After Extra Time
+	StateFTPen           StateID = 8  // Full Time After Penalties
+	StateInplayPenalties StateID = 9  // Penalty Shootout
+	StatePostponed       StateID = 10 // Postponed
+	StateSuspended       StateID = 11 // Suspended
+	StateCancelled       StateID = 12 // Cancelled
+	StateTBA             StateID = 13 // To Be Announced
+	StateWO              StateID = 14 // Walk Over
+	StateAbandoned       StateID = 15 // Abandoned
+	StateDelayed         StateID = 16 // Delayed
+	StateAwarded         StateID = 17 // Technical Loss
+	StateInterrupted     StateID = 18 // Interrupted
+	StateAwaitingUpdates StateID = 19 // Awaiting Updates
+	StateDeleted         StateID = 20 // Deleted
+	StateExtraTimeBreak  StateID = 21 // Extra Time - Break
+	StateInplay2ndHalf   StateID = 22 // 2nd Half
+	StateInplayET2ndHalf StateID = 23 // ET - 2nd Half
+	StatePenBreak        StateID = 25 // Penalties - Break
+	StatePending         StateID = 26 // Pending
+)
+
+var stateIDNames = map[StateID]string{
+	StateNS:              "Not Started",
+	StateInplay1stHalf:   "1st Half",
+	StateHT:              "Half-Time",
+	StateBreak:           "Regular time finished",
+	StateFT:              "Full Time",
+	StateInplayET:        "Extra Time",
+	StateAET:             "Full Time After Extra Time",
+	StateFTPen:           "Full Time After Penalties",
+	StateInplayPenalties: "Penalty Shootout",
+	StatePostponed:       "Postponed",
+	StateSuspended:       "Suspended",
+	StateCancelled:       "Cancelled",
+	StateTBA:             "To Be Announced",
+	StateWO:              "Walk Over",
+	StateAbandoned:       "Abandoned",
+	StateDelayed:         "Delayed",
+	StateAwarded:         "Technical Loss",
+	StateInterrupted:     "Interrupted",
+	StateAwaitingUpdates: "Awaiting Updates",
+	StateDeleted:         "Deleted",
+	StateExtraTimeBreak:  "Extra Time - Break",
+	StateInplay2ndHalf:   "2nd Half",
+	StateInplayET2ndHalf: "ET - 2nd Half",
+	StatePenBreak:        "Penalties - Break",
+	StatePending:         "Pending",
+}
+
+// String returns the name of the StateID within the catalog, or the ID if it is not known.
+func (i StateID) String() string {
+	if name, ok := stateIDNames[i]; ok {
+		return name
+	}
+
+	return "StateID(" + strconv.Itoa(int(i)) + ")"
+}
@@ -0,0 +1,56 @@
+package sportmonks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIDString(t *testing.T) {
+	t.Run("returns the name within the catalog", func(t *testing.T) {
+		assert.Equal(t, "Goal", EventGoal.String())
+		assert.Equal(t, "Expected Goals (xG)", StatExpectedGoals.String())
+		assert.Equal(t, "Current", ScoreCurrent.String())
+		assert.Equal(t, "Attacker", PositionAttacker.String())
+		assert.Equal(t, "Full Time", StateFT.String())
+	})
+
+	t.Run("returns the ID when not known", func(t *testing.T) {
+		assert.Equal(t, "EventTypeID(999)", EventTypeID(999).String())
+		assert.Equal(t, "StateID(0)", StateID(0).String())
+	})
+}
+
+func TestStateID(t *testing.T) {
+	t.Run("classifies in play states", func(t *testing.T) {
+		for _, s := range []StateID{StateInplay1stHalf, StateHT, StateInplay2ndHalf, StateExtraTimeBreak, StatePenBreak} {
+			assert.True(t, s.IsLive(), s.String())
+			assert.False(t, s.IsFinished(), s.String())
+		}
+
+		assert.False(t, StateNS.IsLive())
+		assert.False(t, StateFT.IsLive())
+	})
+
+	t.Run("classifies finished states", func(t *testing.T) {
+		for _, s := range []StateID{StateFT, StateAET, StateFTPen} {
+			assert.True(t, s.IsFinished(), s.String())
+		}
+
+		assert.False(t, StateAbandoned.IsFinished())
+		assert.False(t, StatePostponed.IsFinished())
+	})
+
+	t.Run("classifies postponed states", func(t *testing.T) {
+		assert.True(t, StatePostponed.IsPostponed())
+		assert.False(t, StateDelayed.IsPostponed())
+	})
+
+	t.Run("classifies a fixture state", func(t *testing.T) {
+		state := FixtureState{ID: 5, State: "FT", Name: "Full Time"}
+
+		assert.True(t, state.IsFinished())
+		assert.False(t, state.IsLive())
+		assert.False(t, state.IsPostponed())
+	})
+}
@@ -0,0 +1,140 @@
+package sportmonks
+
+import (
+	"context"
+	"iter"
+	"strconv"
+)
+
+// InplayOdds is an odds price offered by a bookmaker while a fixture is in play.
+type InplayOdds struct {
+	ID                    int        `json:"id"`
+	FixtureID             int        `json:"fixture_id"`
+	ExternalID            *int       `json:"external_id"`
+	MarketID              int        `json:"market_id"`
+	BookmakerID           int        `json:"bookmaker_id"`
+	Label                 string     `json:"label"`
+	Value                 string     `json:"value"`
+	Name                  string     `json:"name"`
+	SortOrder             *int       `json:"sort_order"`
+	MarketDescription     string     `json:"market_description"`
+	Probability           string     `json:"probability"`
+	Dp3                   string     `json:"dp3"`
+	Fractional            string     `json:"fractional"`
+	American              string     `json:"american"`
+	Winning               bool       `json:"winning"`
+	Suspended             bool       `json:"suspended"`
+	Stopped               bool       `json:"stopped"`
+	Total                 *string    `json:"total"`
+	Handicap              *string    `json:"handicap"`
+	Participants          *string    `json:"participants"`
+	CreatedAt             string     `json:"created_at"`
+	UpdatedAt             *string    `json:"updated_at"`
+	OriginalLabel         *string    `json:"original_label"`
+	LatestBookmakerUpdate Time       `json:"latest_bookmaker_update"`
+	Bookmaker             *Bookmaker `json:"bookmaker,omitempty"`
+	Market                *Market    `json:"market,omitempty"`
+}
+
+// DecimalOdds returns the Value of the odds as decimal odds, falling back to Dp3 when Value is empty.
+func (o *InplayOdds) DecimalOdds() (float64, error) {
+	if o.Value == "" && o.Dp3 != "" {
+		return ParseDecimal(o.Dp3)
+	}
+
+	return ParseDecimal(o.Value)
+}
+
+// ImpliedProbability returns the Probability of the odds as a fraction between 0 and 1, calculating it from the
+// decimal odds when Probability is empty.
+func (o *InplayOdds) ImpliedProbability() (float64, error) {
+	if o.Probability != "" {
+		return ParseProbability(o.Probability)
+	}
+
+	d, err := o.DecimalOdds()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return ImpliedProbability(d)
+}
+
+// AllInplayOdds fetches InplayOdds resources for every fixture currently in play. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) AllInplayOdds(ctx context.Context, query *Query, page int) ([]InplayOdds, *ResponseDetails, error) {
+	return multipleInplayOddsResponse(ctx, c, inplayOddsURI, query, page)
+}
+
+// AllInplayOddsIter returns an iterator over InplayOdds resources that transparently requests each page of the
+// paginated endpoint in turn.
+func (c *HTTPClient) AllInplayOddsIter(ctx context.Context, query *Query) iter.Seq2[InplayOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]InplayOdds, *ResponseDetails, error) {
+		return c.AllInplayOdds(ctx, query, page)
+	})
+}
+
+// InplayOddsByFixtureID fetches InplayOdds resources for a fixture. Use the query to enrich and filter the response
+// data.
+func (c *HTTPClient) InplayOddsByFixtureID(ctx context.Context, id int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error) {
+	path := inplayOddsFixtureURI + "/" + strconv.Itoa(id)
+
+	return multipleInplayOddsResponse(ctx, c, path, query, page)
+}
+
+// InplayOddsByFixtureIDIter returns an iterator over InplayOdds resources for a fixture that transparently requests
+// each page of the paginated endpoint in turn.
+func (c *HTTPClient) InplayOddsByFixtureIDIter(ctx context.Context, id int, query *Query) iter.Seq2[InplayOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]InplayOdds, *ResponseDetails, error) {
+		return c.InplayOddsByFixtureID(ctx, id, query, page)
+	})
+}
+
+// InplayOddsByFixtureIDAndBookmakerID fetches InplayOdds resources for a fixture offered by a bookmaker. Use the
+// query to enrich and filter the response data.
+func (c *HTTPClient) InplayOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error) {
+	path := inplayOddsFixtureURI + "/" + strconv.Itoa(fixtureID) + "/bookmakers/" + strconv.Itoa(bookmakerID)
+
+	return multipleInplayOddsResponse(ctx, c, path, query, page)
+}
+
+// InplayOddsByFixtureIDAndBookmakerIDIter returns an iterator over InplayOdds resources for a fixture and bookmaker
+// that transparently requests each page of the paginated endpoint in turn.
+func (c *HTTPClient) InplayOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *Query) iter.Seq2[InplayOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]InplayOdds, *ResponseDetails, error) {
+		return c.InplayOddsByFixtureIDAndBookmakerID(ctx, fixtureID, bookmakerID, query, page)
+	})
+}
+
+// InplayOddsByFixtureIDAndMarketID fetches InplayOdds resources for a fixture and market. Use the query to enrich and
+// filter the response data.
+func (c *HTTPClient) InplayOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *Query, page int) ([]InplayOdds, *ResponseDetails, error) {
+	path := inplayOddsFixtureURI + "/" + strconv.Itoa(fixtureID) + "/markets/" + strconv.Itoa(marketID)
+
+	return multipleInplayOddsResponse(ctx, c, path, query, page)
+}
+
+// InplayOddsByFixtureIDAndMarketIDIter returns an iterator over InplayOdds resources for a fixture and market that
+// transparently requests each page of the paginated endpoint in turn.
+func (c *HTTPClient) InplayOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *Query) iter.Seq2[InplayOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]InplayOdds, *ResponseDetails, error) {
+		return c.InplayOddsByFixtureIDAndMarketID(ctx, fixtureID, marketID, query, page)
+	})
+}
+
+// LatestInplayOdds fetches the InplayOdds resources updated within the last ten seconds. Use the query to enrich and
+// filter the response data.
+func (c *HTTPClient) LatestInplayOdds(ctx context.Context, query *Query) ([]InplayOdds, *ResponseDetails, error) {
+	return multipleInplayOddsResponse(ctx, c, latestInplayOddsURI, query, 0)
+}
+
+func multipleInplayOddsResponse(ctx context.Context, client *HTTPClient, path string, query *Query, page int) ([]InplayOdds, *ResponseDetails, error) {
+	values := query.Values()
+
+	if page != 0 {
+		values.Set("page", strconv.Itoa(page))
+	}
+
+	return getMany[InplayOdds](ctx, client, path, values)
+}
@@ -0,0 +1,215 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var inplayOddsResponse = `{
+	"data": [
+		{
+			"id": 1001,
+			"fixture_id": 19134492,
+			"external_id": 412,
+			"market_id": 1,
+			"bookmaker_id": 2,
+			"label": "Home",
+			"value": "3.40",
+			"name": "Home",
+			"sort_order": 0,
+			"market_description": "Fulltime Result",
+			"probability": "29.41%",
+			"dp3": "3.400",
+			"fractional": "12/5",
+			"american": "240",
+			"winning": false,
+			"suspended": false,
+			"stopped": false,
+			"total": null,
+			"handicap": null,
+			"participants": null,
+			"created_at": "2024-08-17T14:02:11.000000Z",
+			"updated_at": "2024-08-17T14:31:45.000000Z",
+			"original_label": "1",
+			"latest_bookmaker_update": "2024-08-17 14:31:40"
+		}
+	],
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2025-08-01 00:00:00",
+				"current_timestamp": 1723905105
+			},
+			"plans": [
+				{
+					"plan": "Football Advanced",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			]
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3412,
+		"remaining": 2987,
+		"requested_entity": "Odd"
+	},
+	"timezone": "UTC"
+}`
+
+func TestAllInplayOdds(t *testing.T) {
+	url := defaultBaseURL + "/football/odds/inplay?api_token=api-key&page=1"
+
+	t.Run("returns inplay odds struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, inplayOddsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, details, err := client.AllInplayOdds(context.Background(), nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertInplayOdds(t, &odds[0])
+		assert.Equal(t, "Odd", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.AllInplayOdds(context.Background(), nil, 1)
+
+		if odds != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", odds)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestInplayOddsByFixtureID(t *testing.T) {
+	url := defaultBaseURL + "/football/odds/inplay/fixtures/19134492?api_token=api-key&page=1"
+
+	t.Run("returns inplay odds struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, inplayOddsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.InplayOddsByFixtureID(context.Background(), 19134492, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertInplayOdds(t, &odds[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.InplayOddsByFixtureID(context.Background(), 19134492, nil, 1)
+
+		if odds != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", odds)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestInplayOddsByFixtureIDAndBookmakerID(t *testing.T) {
+	url := defaultBaseURL + "/football/odds/inplay/fixtures/19134492/bookmakers/2?api_token=api-key&page=1"
+
+	t.Run("returns inplay odds struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, inplayOddsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.InplayOddsByFixtureIDAndBookmakerID(context.Background(), 19134492, 2, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertInplayOdds(t, &odds[0])
+	})
+}
+
+func TestInplayOddsByFixtureIDAndMarketID(t *testing.T) {
+	url := defaultBaseURL + "/football/odds/inplay/fixtures/19134492/markets/1?api_token=api-key&page=1"
+
+	t.Run("returns inplay odds struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, inplayOddsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.InplayOddsByFixtureIDAndMarketID(context.Background(), 19134492, 1, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertInplayOdds(t, &odds[0])
+	})
+}
+
+func TestLatestInplayOdds(t *testing.T) {
+	url := defaultBaseURL + "/football/odds/inplay/latest?api_token=api-key&filters=markets%3A1"
+
+	t.Run("returns inplay odds struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, inplayOddsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		odds, _, err := client.LatestInplayOdds(context.Background(), NewQuery().Filter("markets", 1))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertInplayOdds(t, &odds[0])
+	})
+}
+
+func assertInplayOdds(t *testing.T, odds *InplayOdds) {
+	assert.Equal(t, 1001, odds.ID)
+	assert.Equal(t, 19134492, odds.FixtureID)
+	assert.Equal(t, 412, *odds.ExternalID)
+	assert.Equal(t, 1, odds.MarketID)
+	assert.Equal(t, 2, odds.BookmakerID)
+	assert.Equal(t, "Home", odds.Label)
+	assert.Equal(t, "3.40", odds.Value)
+	assert.Equal(t, "Home", odds.Name)
+	assert.Equal(t, 0, *odds.SortOrder)
+	assert.Equal(t, "Fulltime Result", odds.MarketDescription)
+	assert.Equal(t, "29.41%", odds.Probability)
+	assert.Equal(t, "3.400", odds.Dp3)
+	assert.Equal(t, "12/5", odds.Fractional)
+	assert.Equal(t, "240", odds.American)
+	assert.False(t, odds.Winning)
+	assert.False(t, odds.Suspended)
+	assert.False(t, odds.Stopped)
+	assert.Nil(t, odds.Total)
+	assert.Nil(t, odds.Handicap)
+	assert.Nil(t, odds.Participants)
+	assert.Equal(t, "2024-08-17T14:02:11.000000Z", odds.CreatedAt)
+	assert.Equal(t, "2024-08-17T14:31:45.000000Z", *odds.UpdatedAt)
+	assert.Equal(t, "1", *odds.OriginalLabel)
+	assert.Equal(t, "2024-08-17 14:31:40", odds.LatestBookmakerUpdate.String())
+
+	decimal, err := odds.DecimalOdds()
+	assert.Nil(t, err)
+	assert.Equal(t, 3.4, decimal)
+
+	probability, err := odds.ImpliedProbability()
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.2941, probability, 1e-9)
+}
@@ -0,0 +1,260 @@
+// Command idgen generates typed constants for the well known type, state and position IDs of the API from a snapshot
+// of the types and states catalog. It is run using 'go generate' from the root of the module.
+//
+// The snapshot files hold the 'data' of the '/core/types' and '/football/states' endpoints. To refresh them, replace
+// their contents with the latest responses and run 'go generate ./...'.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// entry is a type or state of the catalog.
+type entry struct {
+	ID            int    `json:"id"`
+	Name          string `json:"name"`
+	DeveloperName string `json:"developer_name"`
+	ModelType     string `json:"model_type"`
+}
+
+// group is the set of constants generated for a Go type.
+type group struct {
+	Type    string
+	Prefix  string
+	Doc     string
+	Entries []constant
+}
+
+type constant struct {
+	Ident string
+	ID    int
+	Name  string
+}
+
+// groups maps the model type of a catalog type to the Go type generated for it. States are generated separately as
+// the states catalog has no model type.
+var groups = map[string]group{
+	"event":      {Type: "EventTypeID", Prefix: "Event", Doc: "EventTypeID identifies the type of a FixtureEvent, e.g. a goal or a substitution."},
+	"statistic":  {Type: "StatTypeID", Prefix: "Stat", Doc: "StatTypeID identifies the type of a statistic, e.g. corners or expected goals."},
+	"score":      {Type: "ScoreTypeID", Prefix: "Score", Doc: "ScoreTypeID identifies the type of a Score, e.g. the score at half time or the current score."},
+	"position":   {Type: "PositionID", Prefix: "Position", Doc: "PositionID identifies the position or detailed position of a player."},
+	"lineup":     {Type: "LineupTypeID", Prefix: "LineupType", Doc: "LineupTypeID identifies whether a LineupPlayer started the fixture or was on the bench."},
+	"prediction": {Type: "PredictionTypeID", Prefix: "Prediction", Doc: "PredictionTypeID identifies the type of a Probability, e.g. the fulltime result or both teams to score."},
+}
+
+// order is the order of the groups within the generated file.
+var order = []string{"event", "statistic", "score", "position", "lineup", "prediction"}
+
+var state = group{Type: "StateID", Prefix: "State", Doc: "StateID identifies the state of a fixture, e.g. not started, in play or finished."}
+
+// initialisms are the words of developer names kept upper case within identifiers.
+var initialisms = map[string]bool{
+	"AET": true, "BTTS": true, "ET": true, "FT": true, "HT": true, "NS": true, "TBA": true, "VAR": true, "WO": true,
+}
+
+// compounds splits the words that both the name and the developer name of a catalog entry write as one.
+var compounds = map[string][]string{
+	"INSIDEBOX":   {"Inside", "Box"},
+	"OUTSIDEBOX":  {"Outside", "Box"},
+	"REDCARD":     {"Red", "Card"},
+	"REDCARDS":    {"Red", "Cards"},
+	"YELLOWCARD":  {"Yellow", "Card"},
+	"YELLOWCARDS": {"Yellow", "Cards"},
+}
+
+func main() {
+	types := flag.String("types", "", "path to the types catalog snapshot")
+	states := flag.String("states", "", "path to the states catalog snapshot")
+	out := flag.String("out", "", "path to write the generated file to")
+	flag.Parse()
+
+	if *types == "" || *states == "" || *out == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	src, err := generate(*types, *states)
+
+	if err != nil {
+		log.Fatalf("idgen: %s", err)
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatalf("idgen: %s", err)
+	}
+}
+
+// generate returns the formatted source of the constants for the given snapshot files.
+func generate(typesPath, statesPath string) ([]byte, error) {
+	types, err := load(typesPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	states, err := load(statesPath)
+
+	if err != nil {
+		return nil, err
+	}
+
+	byModel := map[string]*group{}
+
+	for model, g := range groups {
+		byModel[model] = &g
+	}
+
+	for _, t := range types {
+		if g, ok := byModel[t.ModelType]; ok {
+			g.Entries = append(g.Entries, constant{ID: t.ID, Name: t.Name, Ident: g.Prefix + ident(t.Name, t.DeveloperName)})
+		}
+	}
+
+	st := state
+
+	for _, s := range states {
+		st.Entries = append(st.Entries, constant{ID: s.ID, Name: s.Name, Ident: st.Prefix + ident(s.Name, s.DeveloperName)})
+	}
+
+	var all []*group
+
+	for _, model := range order {
+		all = append(all, byModel[model])
+	}
+
+	all = append(all, &st)
+
+	for _, g := range all {
+		slices.SortFunc(g.Entries, func(a, b constant) int { return a.ID - b.ID })
+
+		seen := map[string]int{}
+
+		for _, c := range g.Entries {
+			if id, ok := seen[c.Ident]; ok {
+				return nil, fmt.Errorf("%s: IDs %d and %d both generate the identifier %s", g.Type, id, c.ID, c.Ident)
+			}
+
+			seen[c.Ident] = c.ID
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if err := tmpl.Execute(&buf, all); err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+func load(path string) ([]entry, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []entry
+
+	if err := json.Unmarshal(b, &entries); err != nil {
+		return nil, fmt.Errorf("decoding %s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// ident converts the developer name of a catalog entry such as 'INPLAY_1ST_HALF' into the identifier 'Inplay1stHalf'.
+// A developer name word written as several words within the name, e.g. 'OWNGOAL' within 'Own Goal', is split on the
+// word boundaries of the name.
+func ident(name, developerName string) string {
+	nameWords := words(name)
+	var b strings.Builder
+
+	for _, word := range words(developerName) {
+		for _, w := range split(strings.ToUpper(word), nameWords) {
+			if initialisms[strings.ToUpper(w)] {
+				b.WriteString(strings.ToUpper(w))
+				continue
+			}
+
+			b.WriteString(strings.ToUpper(w[:1]) + strings.ToLower(w[1:]))
+		}
+	}
+
+	return b.String()
+}
+
+// split returns the run of two or more name words that together spell word, the known compound words of word, or
+// otherwise word itself.
+func split(word string, nameWords []string) []string {
+	for i := range nameWords {
+		joined := ""
+
+		for j := i; j < len(nameWords) && len(joined) < len(word); j++ {
+			joined += strings.ToUpper(nameWords[j])
+
+			if joined == word && j > i {
+				return nameWords[i : j+1]
+			}
+		}
+	}
+
+	if parts, ok := compounds[word]; ok {
+		return parts
+	}
+
+	return []string{word}
+}
+
+func words(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+var tmpl = template.Must(template.New("ids").Funcs(template.FuncMap{
+	// names returns the unexported name of the map holding the names of a type, e.g. 'stateIDNames'.
+	"names": func(typ string) string {
+		return strings.ToLower(typ[:1]) + typ[1:] + "Names"
+	},
+}).Parse(`// Code generated by idgen from the types and states catalog snapshot. DO NOT EDIT.
+
+package sportmonks
+
+import "strconv"
+{{range .}}
+// {{.Doc}}
+type {{.Type}} int
+
+const (
+{{- $type := .Type}}
+{{- range .Entries}}
+	{{.Ident}} {{$type}} = {{.ID}} // {{.Name}}
+{{- end}}
+)
+
+var {{names .Type}} = map[{{.Type}}]string{
+{{- range .Entries}}
+	{{.Ident}}: {{printf "%q" .Name}},
+{{- end}}
+}
+
+// String returns the name of the {{.Type}} within the catalog, or the ID if it is not known.
+func (i {{.Type}}) String() string {
+	if name, ok := {{names .Type}}[i]; ok {
+		return name
+	}
+
+	return "{{.Type}}(" + strconv.Itoa(int(i)) + ")"
+}
+{{end}}`))
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("generated file is up to date with the catalog snapshot", func(t *testing.T) {
+		src, err := generate("catalog/types.json", "catalog/states.json")
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		existing, err := os.ReadFile("../../ids_gen.go")
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, string(existing), string(src), "ids_gen.go is out of date, run 'go generate ./...'")
+	})
+
+	t.Run("returns an error when two IDs generate the same identifier", func(t *testing.T) {
+		dir := t.TempDir()
+		types := filepath.Join(dir, "types.json")
+		states := filepath.Join(dir, "states.json")
+
+		_ = os.WriteFile(types, []byte(`[
+			{"id": 14, "name": "Goal", "developer_name": "GOAL", "model_type": "event"},
+			{"id": 99, "name": "Goal", "developer_name": "goal", "model_type": "event"}
+		]`), 0o644)
+		_ = os.WriteFile(states, []byte(`[]`), 0o644)
+
+		_, err := generate(types, states)
+
+		assert.EqualError(t, err, "EventTypeID: IDs 14 and 99 both generate the identifier EventGoal")
+	})
+}
+
+func TestIdent(t *testing.T) {
+	assert.Equal(t, "Inplay1stHalf", ident("1st Half", "INPLAY_1ST_HALF"))
+	assert.Equal(t, "FTPen", ident("Full Time After Penalties", "FT_PEN"))
+	assert.Equal(t, "ExpectedGoals", ident("Expected Goals (xG)", "EXPECTED_GOALS"))
+	assert.Equal(t, "VAR", ident("VAR", "VAR"))
+	assert.Equal(t, "OwnGoal", ident("Own Goal", "OWNGOAL"))
+	assert.Equal(t, "YellowRedCard", ident("Yellow/Red card", "YELLOWREDCARD"))
+	assert.Equal(t, "YellowCard", ident("Yellowcard", "YELLOWCARD"))
+	assert.Equal(t, "ShotsInsideBox", ident("Shots Insidebox", "SHOTS_INSIDEBOX"))
+	assert.Equal(t, "BTTSProbability", ident("Both Teams To Score Probability", "BTTS_PROBABILITY"))
+}
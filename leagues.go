@@ -3,9 +3,8 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"strconv"
-	"strings"
 )
 
 // League provides a struct representation of a League resource.
@@ -26,59 +25,28 @@ type League struct {
 
 // Leagues fetches League resources. The endpoint used within this method is paginated, to select the required
 // page use the 'page' method argument. Pagination information including current page and count are included within
-// // the Pagination struct with the ResponseDetails struct. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) Leagues(ctx context.Context, page int, includes []string) ([]League, *ResponseDetails, error) {
-	values := url.Values{
-		"page":    {strconv.Itoa(page)},
-		"include": {strings.Join(includes, ";")},
-	}
+// // the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Leagues(ctx context.Context, page int, query *Query) ([]League, *ResponseDetails, error) {
+	values := query.Values()
 
-	response := struct {
-		Data         []League       `json:"data"`
-		Pagination   Pagination     `json:"pagination"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+	values.Set("page", strconv.Itoa(page))
 
-	err := c.getResource(ctx, leaguesURI, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	return getMany[League](ctx, c, leaguesURI, values)
+}
 
-	return response.Data, &ResponseDetails{
-		Pagination:   &response.Pagination,
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+// LeaguesIter returns an iterator over League resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) LeaguesIter(ctx context.Context, query *Query) iter.Seq2[League, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]League, *ResponseDetails, error) {
+		return c.Leagues(ctx, page, query)
+	})
 }
 
-// LeagueByID fetches League resources by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) LeagueByID(ctx context.Context, id int, includes []string) (*League, *ResponseDetails, error) {
+// LeagueByID fetches League resources by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) LeagueByID(ctx context.Context, id int, query *Query) (*League, *ResponseDetails, error) {
 	path := fmt.Sprintf(leaguesURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data         *League        `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[League](ctx, c, path, values)
 }
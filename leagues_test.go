@@ -142,13 +142,13 @@ var leagueIncludesResponse = `{
 
 func TestLeagues(t *testing.T) {
 	t.Run("returns slice of League struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/leagues?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/football/leagues?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, leaguesResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		leagues, _, err := client.Leagues(context.Background(), 1, []string{})
+		leagues, _, err := client.Leagues(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -164,7 +164,7 @@ func TestLeagues(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		leagues, _, err := client.Leagues(context.Background(), 1, []string{"country", "season", "seasons"})
+		leagues, _, err := client.Leagues(context.Background(), 1, NewQuery().Include("country", "season", "seasons"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -174,13 +174,13 @@ func TestLeagues(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/leagues?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/football/leagues?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		leagues, _, err := client.Leagues(context.Background(), 1, []string{})
+		leagues, _, err := client.Leagues(context.Background(), 1, nil)
 
 		if leagues != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", leagues)
@@ -198,7 +198,7 @@ func TestLeagues(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.Leagues(context.Background(), 1, []string{"country", "season", "seasons"})
+		_, details, _ := client.Leagues(context.Background(), 1, NewQuery().Include("country", "season", "seasons"))
 
 		assertResponseDetails(t, details, "League")
 	})
@@ -206,13 +206,13 @@ func TestLeagues(t *testing.T) {
 
 func TestLeagueByID(t *testing.T) {
 	t.Run("returns a single League struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/leagues/82?api_token=api-key&include="
+		url := defaultBaseURL + "/football/leagues/82?api_token=api-key"
 
 		server := mockResponseServer(t, leagueResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		league, _, err := client.LeagueByID(context.Background(), 82, []string{})
+		league, _, err := client.LeagueByID(context.Background(), 82, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -228,7 +228,7 @@ func TestLeagueByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		league, _, err := client.LeagueByID(context.Background(), 82, []string{"country", "season", "seasons"})
+		league, _, err := client.LeagueByID(context.Background(), 82, NewQuery().Include("country", "season", "seasons"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -238,13 +238,13 @@ func TestLeagueByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/leagues/82?api_token=api-key&include="
+		url := defaultBaseURL + "/football/leagues/82?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		league, _, err := client.LeagueByID(context.Background(), 82, []string{})
+		league, _, err := client.LeagueByID(context.Background(), 82, nil)
 
 		if league != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", league)
@@ -254,13 +254,13 @@ func TestLeagueByID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/leagues/82?api_token=api-key&include="
+		url := defaultBaseURL + "/football/leagues/82?api_token=api-key"
 
 		server := mockResponseServer(t, leagueResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.LeagueByID(context.Background(), 82, []string{})
+		_, details, _ := client.LeagueByID(context.Background(), 82, nil)
 
 		assertResponseDetails(t, details, "League")
 	})
@@ -282,9 +282,9 @@ func assertLeague(t *testing.T, league *League) {
 }
 
 func assertSubscription(t *testing.T, sub Subscription) {
-	assert.Nil(t, sub.Meta[0].TrialEndsAt)
-	assert.Equal(t, "2024-10-26 12:06:34", sub.Meta[0].EndsAt)
-	assert.Equal(t, int64(1728317818), sub.Meta[0].CurrentTimestamp)
+	assert.Nil(t, sub.Meta.TrialEndsAt)
+	assert.Equal(t, "2024-10-26 12:06:34", sub.Meta.EndsAt)
+	assert.Equal(t, int64(1728317818), sub.Meta.CurrentTimestamp)
 
 	assert.Equal(t, 1, len(sub.Plans))
 	assert.Equal(t, "Joe Sweeny Custom Plan", sub.Plans[0].Plan)
@@ -0,0 +1,262 @@
+package sportmonks
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// LiveEventType identifies the kind of change to a fixture reported by a LiveFeed.
+type LiveEventType string
+
+const (
+	LiveEventGoal            LiveEventType = "goal"
+	LiveEventCard            LiveEventType = "card"
+	LiveEventStateChange     LiveEventType = "state_change"
+	LiveEventNewPeriod       LiveEventType = "new_period"
+	LiveEventScoreChange     LiveEventType = "score_change"
+	LiveEventStatisticChange LiveEventType = "statistic_change"
+	LiveEventError           LiveEventType = "error"
+)
+
+// LiveEvent is a change to a fixture detected by a LiveFeed. Fixture holds the latest known state of the fixture, the
+// remaining fields are populated depending on the Type of the event. The Err of a LiveEventStateChange event holds an
+// ErrInvalidTransition when the new state cannot be reached from the previous state, see ValidTransition.
+type LiveEvent struct {
+	Type            LiveEventType
+	FixtureID       int
+	Fixture         *Fixture
+	PreviousStateID int
+	Event           *FixtureEvent
+	Period          *Period
+	Score           *Score
+	Statistic       *FixtureStat
+	Err             error
+}
+
+// defaultLiveFeedInterval is the time between polls when a LiveFeed is not given a positive interval. The latest
+// livescores endpoint returns fixtures updated within the last 10 seconds, so polling less often misses changes.
+const defaultLiveFeedInterval = 5 * time.Second
+
+// LiveFeed polls the latest livescores endpoint and reports changes to in play fixtures as typed LiveEvent values.
+type LiveFeed struct {
+	// Interval is the time between each poll of the latest livescores endpoint. A non-positive interval polls every
+	// 5 seconds.
+	Interval time.Duration
+	// Query is used to enrich the fixtures returned on each poll. Changes can only be detected for the data included,
+	// when nil scores, events, statistics and periods are included.
+	Query  *Query
+	client Client
+}
+
+// NewLiveFeed creates a new LiveFeed polling using the client on the given interval. A non-positive interval polls
+// every 5 seconds.
+func NewLiveFeed(client Client, interval time.Duration) *LiveFeed {
+	if interval <= 0 {
+		interval = defaultLiveFeedInterval
+	}
+
+	return &LiveFeed{
+		Interval: interval,
+		client:   client,
+	}
+}
+
+// Start begins polling and returns a channel of the detected changes. In play fixtures are fetched first to record
+// their current state so only subsequent changes are reported. Fixtures first seen on a later poll are recorded in the
+// same way, and fixtures are forgotten once they reach an outcome such as full time. Request failures are sent as
+// LiveEventError events and polling continues. Polling stops and the channel is closed once ctx is cancelled.
+func (f *LiveFeed) Start(ctx context.Context) <-chan LiveEvent {
+	events := make(chan LiveEvent)
+
+	go f.run(ctx, events)
+
+	return events
+}
+
+func (f *LiveFeed) run(ctx context.Context, events chan<- LiveEvent) {
+	defer close(events)
+
+	query := f.Query
+
+	if query == nil {
+		query = NewQuery().Include("scores", "events", "statistics", "periods")
+	}
+
+	interval := f.Interval
+
+	if interval <= 0 {
+		interval = defaultLiveFeedInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := map[int]Fixture{}
+	primed := false
+
+	for {
+		var fixtures []Fixture
+		var err error
+
+		if primed {
+			fixtures, _, err = f.client.LatestLivescores(ctx, query)
+		} else {
+			fixtures, _, err = f.client.InplayLivescores(ctx, query)
+		}
+
+		if err != nil && ctx.Err() == nil {
+			if !send(ctx, events, LiveEvent{Type: LiveEventError, Err: err}) {
+				return
+			}
+		}
+
+		for _, fixture := range fixtures {
+			prev, ok := seen[fixture.ID]
+			next := mergeFixture(prev, fixture)
+			seen[fixture.ID] = next
+
+			if primed && ok {
+				for _, e := range diffFixture(prev, next) {
+					if !send(ctx, events, e) {
+						return
+					}
+				}
+			}
+
+			if next.Phase().IsTerminal() {
+				delete(seen, fixture.ID)
+			}
+		}
+
+		primed = primed || err == nil
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func send(ctx context.Context, events chan<- LiveEvent, e LiveEvent) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case events <- e:
+		return true
+	}
+}
+
+// mergeFixture carries over relations from the previously seen fixture that are missing from the latest response, so
+// they are not reported as new once they are returned again.
+func mergeFixture(prev, next Fixture) Fixture {
+	if next.Scores == nil {
+		next.Scores = prev.Scores
+	}
+
+	if next.Events == nil {
+		next.Events = prev.Events
+	}
+
+	if next.Statistics == nil {
+		next.Statistics = prev.Statistics
+	}
+
+	if next.Periods == nil {
+		next.Periods = prev.Periods
+	}
+
+	return next
+}
+
+type participantType struct {
+	participantID int
+	typeID        int
+}
+
+// diffFixture returns the events describing the changes between two states of the same fixture.
+func diffFixture(prev, next Fixture) []LiveEvent {
+	var events []LiveEvent
+
+	event := func(t LiveEventType) LiveEvent {
+		return LiveEvent{Type: t, FixtureID: next.ID, Fixture: &next}
+	}
+
+	if prev.StateID != next.StateID {
+		e := event(LiveEventStateChange)
+		e.PreviousStateID = prev.StateID
+		e.Err = ValidateTransition(prev, next)
+		events = append(events, e)
+	}
+
+	periods := map[int]bool{}
+
+	for _, p := range prev.Periods {
+		periods[p.ID] = true
+	}
+
+	for _, p := range next.Periods {
+		if !periods[p.ID] {
+			e := event(LiveEventNewPeriod)
+			e.Period = &p
+			events = append(events, e)
+		}
+	}
+
+	scores := map[participantType]int{}
+
+	for _, s := range prev.Scores {
+		scores[participantType{s.ParticipantID, s.TypeID}] = s.ScoreData.Goals
+	}
+
+	for _, s := range next.Scores {
+		if goals, ok := scores[participantType{s.ParticipantID, s.TypeID}]; !ok || goals != s.ScoreData.Goals {
+			e := event(LiveEventScoreChange)
+			e.Score = &s
+			events = append(events, e)
+		}
+	}
+
+	seen := map[int]bool{}
+
+	for _, ev := range prev.Events {
+		seen[ev.ID] = true
+	}
+
+	for _, ev := range next.Events {
+		if seen[ev.ID] {
+			continue
+		}
+
+		var e LiveEvent
+
+		switch EventTypeID(ev.TypeID) {
+		case EventGoal, EventOwnGoal, EventPenalty:
+			e = event(LiveEventGoal)
+		case EventYellowCard, EventRedCard, EventYellowRedCard:
+			e = event(LiveEventCard)
+		default:
+			continue
+		}
+
+		e.Event = &ev
+		events = append(events, e)
+	}
+
+	stats := map[participantType]interface{}{}
+
+	for _, s := range prev.Statistics {
+		stats[participantType{s.ParticipantID, s.TypeID}] = s.Data.Value
+	}
+
+	for _, s := range next.Statistics {
+		if value, ok := stats[participantType{s.ParticipantID, s.TypeID}]; !ok || !reflect.DeepEqual(value, s.Data.Value) {
+			e := event(LiveEventStatisticChange)
+			e.Statistic = &s
+			events = append(events, e)
+		}
+	}
+
+	return events
+}
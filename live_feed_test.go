@@ -0,0 +1,308 @@
+package sportmonks
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var liveFixtureKickOffResponse = `{
+	"data": [
+		{
+			"id": 19134492,
+			"state_id": 2,
+			"scores": [
+				{"id": 1, "fixture_id": 19134492, "type_id": 1525, "participant_id": 1, "score": {"goals": 0, "participant": "home"}, "description": "CURRENT"},
+				{"id": 2, "fixture_id": 19134492, "type_id": 1525, "participant_id": 2, "score": {"goals": 0, "participant": "away"}, "description": "CURRENT"}
+			],
+			"events": [],
+			"statistics": [
+				{"id": 1, "fixture_id": 19134492, "type_id": 34, "participant_id": 1, "data": {"value": 0}, "location": "home"}
+			],
+			"periods": [
+				{"id": 10, "fixture_id": 19134492, "type_id": 1, "sort_order": 1, "description": "1st-half", "ticking": true}
+			]
+		}
+	]
+}`
+
+var liveFixtureSecondHalfResponse = `{
+	"data": [
+		{
+			"id": 19134492,
+			"state_id": 22,
+			"scores": [
+				{"id": 1, "fixture_id": 19134492, "type_id": 1525, "participant_id": 1, "score": {"goals": 1, "participant": "home"}, "description": "CURRENT"},
+				{"id": 2, "fixture_id": 19134492, "type_id": 1525, "participant_id": 2, "score": {"goals": 0, "participant": "away"}, "description": "CURRENT"}
+			],
+			"events": [
+				{"id": 100, "fixture_id": 19134492, "type_id": 14, "participant_id": 1, "player_name": "H. Kane", "minute": 23},
+				{"id": 101, "fixture_id": 19134492, "type_id": 18, "participant_id": 2, "player_name": "P. Foden", "minute": 40},
+				{"id": 102, "fixture_id": 19134492, "type_id": 19, "participant_id": 2, "player_name": "Rodri", "minute": 44}
+			],
+			"statistics": [
+				{"id": 1, "fixture_id": 19134492, "type_id": 34, "participant_id": 1, "data": {"value": 3}, "location": "home"}
+			],
+			"periods": [
+				{"id": 10, "fixture_id": 19134492, "type_id": 1, "sort_order": 1, "description": "1st-half", "ticking": false},
+				{"id": 11, "fixture_id": 19134492, "type_id": 2, "sort_order": 2, "description": "2nd-half", "ticking": true}
+			]
+		}
+	]
+}`
+
+var liveFixtureWithoutIncludesResponse = `{
+	"data": [
+		{
+			"id": 19134492,
+			"state_id": 22
+		}
+	]
+}`
+
+var liveFixtureFullTimeResponse = `{
+	"data": [
+		{
+			"id": 19134492,
+			"state_id": 5
+		}
+	]
+}`
+
+var liveFixtureFullTimeWithEventResponse = `{
+	"data": [
+		{
+			"id": 19134492,
+			"state_id": 5,
+			"events": [
+				{"id": 1000, "fixture_id": 19134492, "participant_id": 1, "type_id": 14, "player_name": "H. Kane", "minute": 90}
+			]
+		}
+	]
+}`
+
+// liveFeedServer serves the inplay response followed by each latest response in turn, repeating the final response
+// once all have been served.
+func liveFeedServer(inplay *http.Response, latest ...string) *http.Client {
+	var mu sync.Mutex
+	calls := 0
+
+	return newTestClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.URL.Path == "/v3/football/livescores/inplay" {
+			return inplay
+		}
+
+		body := latest[len(latest)-1]
+
+		if calls < len(latest) {
+			body = latest[calls]
+		}
+
+		calls++
+
+		return stringResponse(200, body)
+	})
+}
+
+func receiveLiveEvents(t *testing.T, events <-chan LiveEvent, n int) []LiveEvent {
+	var received []LiveEvent
+
+	for len(received) < n {
+		select {
+		case e := <-events:
+			received = append(received, e)
+		case <-time.After(time.Second):
+			t.Fatalf("Test failed, expected %d events, got %d", n, len(received))
+		}
+	}
+
+	return received
+}
+
+func TestLiveFeed(t *testing.T) {
+	t.Run("emits typed change events between polls", func(t *testing.T) {
+		server := liveFeedServer(
+			stringResponse(200, liveFixtureKickOffResponse),
+			liveFixtureSecondHalfResponse,
+			liveFixtureWithoutIncludesResponse,
+			liveFixtureSecondHalfResponse,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := receiveLiveEvents(t, feed.Start(ctx), 6)
+
+		types := make([]LiveEventType, len(events))
+
+		for i, e := range events {
+			types[i] = e.Type
+			assert.Equal(t, 19134492, e.FixtureID)
+		}
+
+		expected := []LiveEventType{
+			LiveEventStateChange,
+			LiveEventNewPeriod,
+			LiveEventScoreChange,
+			LiveEventGoal,
+			LiveEventCard,
+			LiveEventStatisticChange,
+		}
+
+		assert.Equal(t, expected, types)
+		assert.Equal(t, 2, events[0].PreviousStateID)
+		assert.Equal(t, 22, events[0].Fixture.StateID)
+		assert.Nil(t, events[0].Err)
+		assert.Equal(t, 11, events[1].Period.ID)
+		assert.Equal(t, 1, events[2].Score.ScoreData.Goals)
+		assert.Equal(t, "H. Kane", events[3].Event.PlayerName)
+		assert.Equal(t, "Rodri", events[4].Event.PlayerName)
+		assert.Equal(t, float64(3), events[5].Statistic.Data.Value)
+	})
+
+	t.Run("does not report changes again when relations are missing from a poll", func(t *testing.T) {
+		server := liveFeedServer(
+			stringResponse(200, liveFixtureKickOffResponse),
+			liveFixtureSecondHalfResponse,
+			liveFixtureWithoutIncludesResponse,
+			liveFixtureSecondHalfResponse,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := feed.Start(ctx)
+
+		receiveLiveEvents(t, events, 6)
+
+		select {
+		case e := <-events:
+			t.Fatalf("Test failed, expected no further events, got %s", e.Type)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		cancel()
+	})
+
+	t.Run("flags state changes that are not possible", func(t *testing.T) {
+		server := liveFeedServer(stringResponse(200, liveFixtureSecondHalfResponse), liveFixtureKickOffResponse)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := receiveLiveEvents(t, feed.Start(ctx), 1)
+
+		assert.Equal(t, LiveEventStateChange, events[0].Type)
+		assert.Equal(t, &ErrInvalidTransition{FixtureID: 19134492, From: StateInplay2ndHalf, To: StateInplay1stHalf}, events[0].Err)
+	})
+
+	t.Run("emits request errors and keeps polling", func(t *testing.T) {
+		server := liveFeedServer(stringResponse(400, errorResponse), liveFixtureSecondHalfResponse)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := receiveLiveEvents(t, feed.Start(ctx), 2)
+
+		assert.Equal(t, LiveEventError, events[0].Type)
+		assertError(t, events[0].Err)
+		assert.Equal(t, LiveEventError, events[1].Type)
+	})
+
+	t.Run("does not report the existing state of fixtures first seen after priming", func(t *testing.T) {
+		server := liveFeedServer(stringResponse(200, `{"data": []}`), liveFixtureSecondHalfResponse)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		select {
+		case e := <-feed.Start(ctx):
+			t.Fatalf("Test failed, expected no events, got %s", e.Type)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("forgets fixtures once finished", func(t *testing.T) {
+		server := liveFeedServer(
+			stringResponse(200, liveFixtureKickOffResponse),
+			liveFixtureFullTimeResponse,
+			liveFixtureFullTimeWithEventResponse,
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := feed.Start(ctx)
+
+		received := receiveLiveEvents(t, events, 1)
+
+		assert.Equal(t, LiveEventStateChange, received[0].Type)
+		assert.Equal(t, 5, received[0].Fixture.StateID)
+
+		select {
+		case e := <-events:
+			t.Fatalf("Test failed, expected no further events, got %s", e.Type)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("polls on a default interval when the interval is not positive", func(t *testing.T) {
+		server := liveFeedServer(stringResponse(200, liveFixtureKickOffResponse), liveFixtureKickOffResponse)
+
+		feed := NewLiveFeed(newTestHTTPClient(server), 0)
+
+		assert.Equal(t, defaultLiveFeedInterval, feed.Interval)
+
+		feed.Interval = -time.Second
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		events := feed.Start(ctx)
+
+		cancel()
+
+		select {
+		case _, ok := <-events:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("Test failed, expected channel to be closed")
+		}
+	})
+
+	t.Run("closes the channel once the context is cancelled", func(t *testing.T) {
+		server := liveFeedServer(stringResponse(200, liveFixtureKickOffResponse), liveFixtureKickOffResponse)
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		feed := NewLiveFeed(newTestHTTPClient(server), time.Millisecond)
+
+		events := feed.Start(ctx)
+
+		cancel()
+
+		select {
+		case _, ok := <-events:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("Test failed, expected channel to be closed")
+		}
+	})
+}
@@ -0,0 +1,23 @@
+package sportmonks
+
+import (
+	"context"
+)
+
+// Livescores fetches Fixture resources for all fixtures taking place today. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) Livescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error) {
+	return multipleFixtureResponse(ctx, c, livescoresURI, query, 1)
+}
+
+// InplayLivescores fetches Fixture resources for all fixtures currently in play. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) InplayLivescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error) {
+	return multipleFixtureResponse(ctx, c, livescoresInplayURI, query, 1)
+}
+
+// LatestLivescores fetches Fixture resources for all fixtures updated within the last 10 seconds. Use the query to
+// enrich and filter the response data.
+func (c *HTTPClient) LatestLivescores(ctx context.Context, query *Query) ([]Fixture, *ResponseDetails, error) {
+	return multipleFixtureResponse(ctx, c, livescoresLatestURI, query, 1)
+}
@@ -0,0 +1,175 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var livescoresResponse = `{
+	"data": [
+		{
+			"id": 463,
+			"sport_id": 1,
+			"league_id": 8,
+			"season_id": 2,
+			"stage_id": 2,
+			"group_id": null,
+			"aggregate_id": null,
+			"round_id": 43,
+			"state_id": 5,
+			"venue_id": null,
+			"name": "Tottenham Hotspur vs Manchester City",
+			"starting_at": "2010-08-14 11:45:00",
+			"result_info": "Game ended in draw.",
+			"leg": "1/1",
+			"details": null,
+			"length": 90,
+			"placeholder": false,
+			"has_odds": false,
+			"has_premium_odds": false,
+			"starting_at_timestamp": 1281786300,
+			"periods": [
+				{
+					"id": 4432381,
+					"fixture_id": 463,
+					"type_id": 1,
+					"started": 1281786300,
+					"ended": 1281789000,
+					"counts_from": 0,
+					"ticking": false,
+					"sort_order": 1,
+					"description": "1st-half",
+					"time_added": 2,
+					"period_length": 45,
+					"minutes": null,
+					"seconds": null
+				}
+			]
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3600,
+		"remaining": 2999,
+		"requested_entity": "Fixture"
+	},
+	"timezone": "UTC"
+}`
+
+func TestLivescores(t *testing.T) {
+	t.Run("returns slice of Fixture struct", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores?api_token=api-key&page=1"
+
+		server := mockResponseServer(t, livescoresResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, details, err := client.Livescores(context.Background(), nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertFixture(t, &fixtures[0])
+		assertPeriod(t, fixtures[0].Periods[0])
+		assert.Equal(t, "Fixture", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores?api_token=api-key&page=1"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, _, err := client.Livescores(context.Background(), nil)
+
+		assert.Nil(t, fixtures)
+		assertError(t, err)
+	})
+}
+
+func TestInplayLivescores(t *testing.T) {
+	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores/inplay?api_token=api-key&include=periods&page=1"
+
+		server := mockResponseServer(t, livescoresResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, _, err := client.InplayLivescores(context.Background(), NewQuery().Include("periods"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertFixture(t, &fixtures[0])
+		assertPeriod(t, fixtures[0].Periods[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores/inplay?api_token=api-key&page=1"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, _, err := client.InplayLivescores(context.Background(), nil)
+
+		assert.Nil(t, fixtures)
+		assertError(t, err)
+	})
+}
+
+func TestLatestLivescores(t *testing.T) {
+	t.Run("returns slice of Fixture struct with includes data", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores/latest?api_token=api-key&include=periods&page=1"
+
+		server := mockResponseServer(t, livescoresResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, _, err := client.LatestLivescores(context.Background(), NewQuery().Include("periods"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertFixture(t, &fixtures[0])
+		assertPeriod(t, fixtures[0].Periods[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/livescores/latest?api_token=api-key&page=1"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		fixtures, _, err := client.LatestLivescores(context.Background(), nil)
+
+		assert.Nil(t, fixtures)
+		assertError(t, err)
+	})
+}
+
+func assertPeriod(t *testing.T, period Period) {
+	started := int64(1281786300)
+	ended := int64(1281789000)
+	added := 2
+
+	assert.Equal(t, 4432381, period.ID)
+	assert.Equal(t, 463, period.FixtureID)
+	assert.Equal(t, 1, period.TypeID)
+	assert.Equal(t, &started, period.Started)
+	assert.Equal(t, &ended, period.Ended)
+	assert.Equal(t, 0, period.CountsFrom)
+	assert.False(t, period.Ticking)
+	assert.Equal(t, 1, period.SortOrder)
+	assert.Equal(t, "1st-half", period.Description)
+	assert.Equal(t, &added, period.TimeAdded)
+	assert.Equal(t, 45, period.PeriodLength)
+	assert.Nil(t, period.Minutes)
+	assert.Nil(t, period.Seconds)
+}
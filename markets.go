@@ -0,0 +1,52 @@
+package sportmonks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// Market provides a struct representation of an odds Market resource, e.g. 'Fulltime Result'.
+type Market struct {
+	ID                     int    `json:"id"`
+	LegacyID               *int   `json:"legacy_id"`
+	Name                   string `json:"name"`
+	DeveloperName          string `json:"developer_name"`
+	HasWinningCalculations bool   `json:"has_winning_calculations"`
+}
+
+// Markets fetches Market resources. The endpoint used within this method is paginated, to select the required page
+// use the 'page' method argument. Pagination information including current page and count are included within the
+// Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Markets(ctx context.Context, page int, query *Query) ([]Market, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[Market](ctx, c, marketsURI, values)
+}
+
+// MarketsIter returns an iterator over Market resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) MarketsIter(ctx context.Context, query *Query) iter.Seq2[Market, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Market, *ResponseDetails, error) {
+		return c.Markets(ctx, page, query)
+	})
+}
+
+// MarketByID fetches a Market resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) MarketByID(ctx context.Context, id int, query *Query) (*Market, *ResponseDetails, error) {
+	path := fmt.Sprintf(marketsURI+"/%d", id)
+
+	return getOne[Market](ctx, c, path, query.Values())
+}
+
+// MarketSearch fetches the Market resources with a name matching the search term. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) MarketSearch(ctx context.Context, name string, query *Query) ([]Market, *ResponseDetails, error) {
+	path := marketsSearchURI + "/" + url.PathEscape(name)
+
+	return getMany[Market](ctx, c, path, query.Values())
+}
@@ -0,0 +1,135 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var marketsResponse = `{
+	"data": [
+		{
+			"id": 1,
+			"legacy_id": 1,
+			"name": "Fulltime Result",
+			"developer_name": "FULLTIME_RESULT",
+			"has_winning_calculations": true
+		}
+	],
+	"pagination": {
+		"count": 1,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Market"
+	},
+	"timezone": "UTC"
+}`
+
+var marketResponse = `{
+	"data": {
+		"id": 1,
+		"legacy_id": 1,
+		"name": "Fulltime Result",
+		"developer_name": "FULLTIME_RESULT",
+		"has_winning_calculations": true
+	},
+	"timezone": "UTC"
+}`
+
+func TestMarkets(t *testing.T) {
+	url := defaultBaseURL + "/odds/markets?api_token=api-key&page=1"
+
+	t.Run("returns Market struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, marketsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		markets, details, err := client.Markets(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertMarket(t, &markets[0])
+		assert.Equal(t, "Market", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		markets, _, err := client.Markets(context.Background(), 1, nil)
+
+		if markets != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", markets)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestMarketByID(t *testing.T) {
+	url := defaultBaseURL + "/odds/markets/1?api_token=api-key"
+
+	t.Run("returns a single Market struct", func(t *testing.T) {
+		server := mockResponseServer(t, marketResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		market, _, err := client.MarketByID(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertMarket(t, market)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		market, _, err := client.MarketByID(context.Background(), 1, nil)
+
+		if market != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", market)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestMarketSearch(t *testing.T) {
+	t.Run("returns Market struct slice", func(t *testing.T) {
+		url := defaultBaseURL + "/odds/markets/search/Fulltime?api_token=api-key"
+
+		server := mockResponseServer(t, marketsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		markets, _, err := client.MarketSearch(context.Background(), "Fulltime", nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertMarket(t, &markets[0])
+	})
+}
+
+func assertMarket(t *testing.T, market *Market) {
+	assert.Equal(t, 1, market.ID)
+	assert.Equal(t, 1, *market.LegacyID)
+	assert.Equal(t, "Fulltime Result", market.Name)
+	assert.Equal(t, "FULLTIME_RESULT", market.DeveloperName)
+	assert.True(t, market.HasWinningCalculations)
+}
@@ -2,100 +2,106 @@ package sportmonks
 
 import (
 	"context"
-	"net/url"
+	"iter"
 	"strconv"
-	"strings"
 )
 
 type PrematchOdds struct {
-	ID                    int     `json:"id"`
-	FixtureID             int     `json:"fixture_id"`
-	MarketID              int     `json:"market_id"`
-	BookmakerID           int     `json:"bookmaker_id"`
-	Label                 string  `json:"label"`
-	Value                 string  `json:"value"`
-	Name                  string  `json:"name"`
-	SortOrder             *int    `json:"sort_order"`
-	MarketDescription     string  `json:"market_description"`
-	Probability           string  `json:"probability"`
-	Dp3                   string  `json:"dp3"`
-	Fractional            string  `json:"fractional"`
-	American              string  `json:"american"`
-	Winning               bool    `json:"winning"`
-	Stopped               bool    `json:"stopped"`
-	Total                 string  `json:"total"`
-	Handicap              string  `json:"handicap"`
-	Participants          string  `json:"participants"`
-	CreatedAt             string  `json:"created_at"`
-	OriginalLabel         *string `json:"original_label"`
-	LatestBookmakerUpdate string  `json:"latest_bookmaker_update"`
-	Fixture               Fixture `json:"fixture"`
+	ID                    int        `json:"id"`
+	FixtureID             int        `json:"fixture_id"`
+	MarketID              int        `json:"market_id"`
+	BookmakerID           int        `json:"bookmaker_id"`
+	Label                 string     `json:"label"`
+	Value                 string     `json:"value"`
+	Name                  string     `json:"name"`
+	SortOrder             *int       `json:"sort_order"`
+	MarketDescription     string     `json:"market_description"`
+	Probability           string     `json:"probability"`
+	Dp3                   string     `json:"dp3"`
+	Fractional            string     `json:"fractional"`
+	American              string     `json:"american"`
+	Winning               bool       `json:"winning"`
+	Stopped               bool       `json:"stopped"`
+	Total                 *string    `json:"total"`
+	Handicap              *string    `json:"handicap"`
+	Participants          string     `json:"participants"`
+	CreatedAt             string     `json:"created_at"`
+	OriginalLabel         *string    `json:"original_label"`
+	LatestBookmakerUpdate Time       `json:"latest_bookmaker_update"`
+	Fixture               Fixture    `json:"fixture"`
+	Bookmaker             *Bookmaker `json:"bookmaker,omitempty"`
+	Market                *Market    `json:"market,omitempty"`
 }
 
-func (c *HTTPClient) AllPrematchOdds(ctx context.Context, includes []string, filters map[string][]int, page int) ([]PrematchOdds, *ResponseDetails, error) {
+func (c *HTTPClient) AllPrematchOdds(ctx context.Context, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error) {
 	path := prematchOddsURI
 
-	return multipleOddsResponse(ctx, c, path, includes, filters, page)
+	return multipleOddsResponse(ctx, c, path, query, page)
 }
 
-func (c *HTTPClient) PrematchOddsByFixtureID(ctx context.Context, id int, includes []string, filters map[string][]int, page int) ([]PrematchOdds, *ResponseDetails, error) {
+// AllPrematchOddsIter returns an iterator over PrematchOdds resources that transparently requests each page of the
+// paginated endpoint in turn.
+func (c *HTTPClient) AllPrematchOddsIter(ctx context.Context, query *Query) iter.Seq2[PrematchOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]PrematchOdds, *ResponseDetails, error) {
+		return c.AllPrematchOdds(ctx, query, page)
+	})
+}
+
+func (c *HTTPClient) PrematchOddsByFixtureID(ctx context.Context, id int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error) {
 	path := prematchOddsURIByFixtureID + "/" + strconv.Itoa(id)
 
-	return multipleOddsResponse(ctx, c, path, includes, filters, page)
+	return multipleOddsResponse(ctx, c, path, query, page)
+}
+
+// PrematchOddsByFixtureIDIter returns an iterator over PrematchOdds resources for a fixture that transparently requests
+// each page of the paginated endpoint in turn.
+func (c *HTTPClient) PrematchOddsByFixtureIDIter(ctx context.Context, id int, query *Query) iter.Seq2[PrematchOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]PrematchOdds, *ResponseDetails, error) {
+		return c.PrematchOddsByFixtureID(ctx, id, query, page)
+	})
 }
 
-func (c *HTTPClient) PrematchOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, includes []string, filters map[string][]int, page int) ([]PrematchOdds, *ResponseDetails, error) {
+func (c *HTTPClient) PrematchOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error) {
 	path := prematchOddsURIByFixtureID + "/" + strconv.Itoa(fixtureID) + "/bookmakers/" + strconv.Itoa(bookmakerID)
 
-	return multipleOddsResponse(ctx, c, path, includes, filters, page)
+	return multipleOddsResponse(ctx, c, path, query, page)
 }
 
-func (c *HTTPClient) PrematchOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, includes []string, filters map[string][]int, page int) ([]PrematchOdds, *ResponseDetails, error) {
-	path := prematchOddsURIByFixtureID + "/" + strconv.Itoa(fixtureID) + "/markets/" + strconv.Itoa(marketID)
-
-	return multipleOddsResponse(ctx, c, path, includes, filters, page)
+// PrematchOddsByFixtureIDAndBookmakerIDIter returns an iterator over PrematchOdds resources for a fixture and bookmaker
+// that transparently requests each page of the paginated endpoint in turn.
+func (c *HTTPClient) PrematchOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *Query) iter.Seq2[PrematchOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]PrematchOdds, *ResponseDetails, error) {
+		return c.PrematchOddsByFixtureIDAndBookmakerID(ctx, fixtureID, bookmakerID, query, page)
+	})
 }
 
-func (c *HTTPClient) LatestOdds(ctx context.Context, includes []string, filters map[string][]int) ([]PrematchOdds, *ResponseDetails, error) {
-	path := lastUpdatedOddsURI
+func (c *HTTPClient) PrematchOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error) {
+	path := prematchOddsURIByFixtureID + "/" + strconv.Itoa(fixtureID) + "/markets/" + strconv.Itoa(marketID)
 
-	return multipleOddsResponse(ctx, c, path, includes, filters, 0)
+	return multipleOddsResponse(ctx, c, path, query, page)
 }
 
-func multipleOddsResponse(ctx context.Context, client *HTTPClient, path string, includes []string, filters map[string][]int, page int) ([]PrematchOdds, *ResponseDetails, error) {
-
-	var values url.Values
+// PrematchOddsByFixtureIDAndMarketIDIter returns an iterator over PrematchOdds resources for a fixture and market that
+// transparently requests each page of the paginated endpoint in turn.
+func (c *HTTPClient) PrematchOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *Query) iter.Seq2[PrematchOdds, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]PrematchOdds, *ResponseDetails, error) {
+		return c.PrematchOddsByFixtureIDAndMarketID(ctx, fixtureID, marketID, query, page)
+	})
+}
 
-	if page == 0 {
-		values = url.Values{
-			"include": {strings.Join(includes, ";")},
-		}
-	} else {
-		values = url.Values{
-			"include": {strings.Join(includes, ";")},
-			"page":    {strconv.Itoa(page)},
-		}
-	}
+func (c *HTTPClient) LatestOdds(ctx context.Context, query *Query) ([]PrematchOdds, *ResponseDetails, error) {
+	path := lastUpdatedOddsURI
 
-	formatFilters(&values, filters)
+	return multipleOddsResponse(ctx, c, path, query, 0)
+}
 
-	response := struct {
-		Data         []PrematchOdds `json:"data"`
-		Pagination   *Pagination    `json:"pagination"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+func multipleOddsResponse(ctx context.Context, client *HTTPClient, path string, query *Query, page int) ([]PrematchOdds, *ResponseDetails, error) {
 
-	err := client.getResource(ctx, path, values, &response)
+	values := query.Values()
 
-	if err != nil {
-		return nil, nil, err
+	if page != 0 {
+		values.Set("page", strconv.Itoa(page))
 	}
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, nil
+	return getMany[PrematchOdds](ctx, client, path, values)
 }
@@ -0,0 +1,138 @@
+package sportmonks
+
+import (
+	"context"
+	"sync"
+)
+
+// OddsCatalog holds every Bookmaker and Market resource, loaded once, and uses them to enrich odds with the bookmaker
+// and market they belong to without including them in every odds request. An OddsCatalog is safe for concurrent use.
+type OddsCatalog struct {
+	client Client
+	// load serialises calls to Load, so the catalogs are fetched once without holding mu during the requests.
+	load       sync.Mutex
+	mu         sync.Mutex
+	loaded     bool
+	bookmakers map[int]Bookmaker
+	markets    map[int]Market
+}
+
+// NewOddsCatalog creates a new OddsCatalog loading bookmakers and markets using the client.
+func NewOddsCatalog(client Client) *OddsCatalog {
+	return &OddsCatalog{client: client}
+}
+
+// Load fetches every Bookmaker and Market resource. Only the first successful call makes requests, a failed load is
+// retried by the next call. Odds can be enriched while a load is in progress.
+func (c *OddsCatalog) Load(ctx context.Context) error {
+	c.load.Lock()
+	defer c.load.Unlock()
+
+	c.mu.Lock()
+	loaded := c.loaded
+	c.mu.Unlock()
+
+	if loaded {
+		return nil
+	}
+
+	bookmakers, err := Collect(c.client.BookmakersIter(ctx, nil), 0)
+
+	if err != nil {
+		return err
+	}
+
+	markets, err := Collect(c.client.MarketsIter(ctx, nil), 0)
+
+	if err != nil {
+		return err
+	}
+
+	byBookmaker := make(map[int]Bookmaker, len(bookmakers))
+	byMarket := make(map[int]Market, len(markets))
+
+	for _, b := range bookmakers {
+		byBookmaker[b.ID] = b
+	}
+
+	for _, m := range markets {
+		byMarket[m.ID] = m
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.bookmakers = byBookmaker
+	c.markets = byMarket
+	c.loaded = true
+
+	return nil
+}
+
+// Bookmaker returns the loaded Bookmaker with the given ID. The boolean is false if the ID is unknown or the catalog
+// has not been loaded.
+func (c *OddsCatalog) Bookmaker(id int) (Bookmaker, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.bookmakers[id]
+
+	return b, ok
+}
+
+// Market returns the loaded Market with the given ID. The boolean is false if the ID is unknown or the catalog has not
+// been loaded.
+func (c *OddsCatalog) Market(id int) (Market, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	m, ok := c.markets[id]
+
+	return m, ok
+}
+
+// EnrichPrematchOdds sets the Bookmaker and Market of each odds, loading the catalog first if required. Odds with an
+// unknown bookmaker or market are left unchanged.
+func (c *OddsCatalog) EnrichPrematchOdds(ctx context.Context, odds []PrematchOdds) error {
+	if err := c.Load(ctx); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := range odds {
+		c.enrich(odds[i].BookmakerID, odds[i].MarketID, &odds[i].Bookmaker, &odds[i].Market)
+	}
+
+	return nil
+}
+
+// EnrichInplayOdds sets the Bookmaker and Market of each odds, loading the catalog first if required. Odds with an
+// unknown bookmaker or market are left unchanged.
+func (c *OddsCatalog) EnrichInplayOdds(ctx context.Context, odds []InplayOdds) error {
+	if err := c.Load(ctx); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := range odds {
+		c.enrich(odds[i].BookmakerID, odds[i].MarketID, &odds[i].Bookmaker, &odds[i].Market)
+	}
+
+	return nil
+}
+
+// enrich points bookmaker and market at copies of the catalog entries with the given IDs, leaving them unchanged when
+// an ID is unknown. The caller must hold c.mu.
+func (c *OddsCatalog) enrich(bookmakerID, marketID int, bookmaker **Bookmaker, market **Market) {
+	if b, ok := c.bookmakers[bookmakerID]; ok {
+		*bookmaker = &b
+	}
+
+	if m, ok := c.markets[marketID]; ok {
+		*market = &m
+	}
+}
@@ -0,0 +1,144 @@
+package sportmonks
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// oddsCatalogServer serves the bookmakers and markets responses, counting the requests made to each.
+func oddsCatalogServer(requests map[string]int, code int, bookmakers string) *http.Client {
+	var mu sync.Mutex
+
+	return newTestClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests[req.URL.Path]++
+
+		if req.URL.Path == "/v3/odds/bookmakers" {
+			return stringResponse(code, bookmakers)
+		}
+
+		return stringResponse(200, marketsResponse)
+	})
+}
+
+func TestOddsCatalog(t *testing.T) {
+	t.Run("enriches odds with the bookmaker and market", func(t *testing.T) {
+		requests := map[string]int{}
+
+		client := newTestHTTPClient(oddsCatalogServer(requests, 200, bookmakersResponse))
+
+		catalog := NewOddsCatalog(client)
+
+		prematch := []PrematchOdds{
+			{ID: 1, BookmakerID: 2, MarketID: 1},
+			{ID: 2, BookmakerID: 9, MarketID: 80},
+		}
+
+		if err := catalog.EnrichPrematchOdds(context.Background(), prematch); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "bet365", prematch[0].Bookmaker.Name)
+		assert.Equal(t, "Fulltime Result", prematch[0].Market.Name)
+		assert.Nil(t, prematch[1].Bookmaker)
+		assert.Nil(t, prematch[1].Market)
+
+		inplay := []InplayOdds{{ID: 1, BookmakerID: 2, MarketID: 1}}
+
+		if err := catalog.EnrichInplayOdds(context.Background(), inplay); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "bet365", inplay[0].Bookmaker.Name)
+		assert.Equal(t, "FULLTIME_RESULT", inplay[0].Market.DeveloperName)
+
+		assert.Equal(t, 1, requests["/v3/odds/bookmakers"])
+		assert.Equal(t, 1, requests["/v3/odds/markets"])
+	})
+
+	t.Run("looks up loaded resources by ID", func(t *testing.T) {
+		client := newTestHTTPClient(oddsCatalogServer(map[string]int{}, 200, bookmakersResponse))
+
+		catalog := NewOddsCatalog(client)
+
+		_, ok := catalog.Bookmaker(2)
+		assert.False(t, ok)
+
+		if err := catalog.Load(context.Background()); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		bookmaker, ok := catalog.Bookmaker(2)
+		assert.True(t, ok)
+		assert.Equal(t, "bet365", bookmaker.Name)
+
+		market, ok := catalog.Market(1)
+		assert.True(t, ok)
+		assert.Equal(t, "Fulltime Result", market.Name)
+
+		_, ok = catalog.Market(80)
+		assert.False(t, ok)
+	})
+
+	t.Run("looks up resources while a load is in progress", func(t *testing.T) {
+		requested := make(chan struct{})
+		release := make(chan struct{})
+		var once sync.Once
+
+		server := newTestClient(func(req *http.Request) *http.Response {
+			once.Do(func() { close(requested) })
+			<-release
+
+			if req.URL.Path == "/v3/odds/bookmakers" {
+				return stringResponse(200, bookmakersResponse)
+			}
+
+			return stringResponse(200, marketsResponse)
+		})
+
+		catalog := NewOddsCatalog(newTestHTTPClient(server))
+
+		done := make(chan error)
+
+		go func() {
+			done <- catalog.Load(context.Background())
+		}()
+
+		<-requested
+
+		_, ok := catalog.Bookmaker(2)
+
+		assert.False(t, ok)
+
+		close(release)
+
+		assert.Nil(t, <-done)
+
+		_, ok = catalog.Bookmaker(2)
+
+		assert.True(t, ok)
+	})
+
+	t.Run("returns the error of a failed load and retries on the next call", func(t *testing.T) {
+		requests := map[string]int{}
+
+		client := newTestHTTPClient(oddsCatalogServer(requests, 400, errorResponse))
+
+		catalog := NewOddsCatalog(client)
+
+		odds := []PrematchOdds{{ID: 1, BookmakerID: 2, MarketID: 1}}
+
+		assertError(t, catalog.EnrichPrematchOdds(context.Background(), odds))
+		assertError(t, catalog.Load(context.Background()))
+
+		assert.Nil(t, odds[0].Bookmaker)
+		assert.Equal(t, 2, requests["/v3/odds/bookmakers"])
+		assert.Equal(t, 0, requests["/v3/odds/markets"])
+	})
+}
@@ -0,0 +1,160 @@
+package sportmonks
+
+import "slices"
+
+// MarketKey identifies a single market line of a fixture across every bookmaker offering it.
+type MarketKey struct {
+	FixtureID int
+	MarketID  int
+	Total     string
+	Handicap  string
+}
+
+// MarketKeyOf returns the MarketKey of a book.
+func MarketKeyOf(k BookKey) MarketKey {
+	return MarketKey{FixtureID: k.FixtureID, MarketID: k.MarketID, Total: k.Total, Handicap: k.Handicap}
+}
+
+// SelectionComparison compares the prices offered by each bookmaker on one outcome of a market line. Prices are
+// decimal odds.
+type SelectionComparison struct {
+	Label string
+	// BestPrice is the highest price offered.
+	BestPrice float64
+	// BestBookmakerID is the bookmaker offering the BestPrice, the lowest ID winning a tie.
+	BestBookmakerID int
+	// Consensus is the median of the prices offered.
+	Consensus float64
+	// BookmakerCount is the number of bookmakers offering a price.
+	BookmakerCount int
+	// FairProbability is the probability implied by the Consensus price with the margin removed, so the fair
+	// probabilities of every outcome of the market line total 1. It is zero unless the comparison is Complete.
+	FairProbability float64
+}
+
+// MarketComparison compares the prices offered by each bookmaker on a market line.
+type MarketComparison struct {
+	Key MarketKey
+	// Selections holds a comparison for each outcome, in the order the outcomes first appear within the odds. An
+	// outcome whose odds are all stopped or malformed is held with a BookmakerCount of zero.
+	Selections []SelectionComparison
+	// Complete is true when the market line has at least two outcomes and every outcome has a price. The
+	// BestOverround, Arbitrage and FairProbability of each selection are only set for a complete comparison.
+	Complete bool
+	// BestOverround is the Overround of a book made up of the BestPrice of every outcome, which is negative when an
+	// arbitrage opportunity exists.
+	BestOverround float64
+	// Arbitrage is true when the implied probabilities of the BestPrice of every outcome total less than 1, meaning a
+	// profit is guaranteed by backing every outcome at the best price. Outcomes not offered by any bookmaker are not
+	// accounted for, so the odds should hold every outcome of the market line.
+	Arbitrage bool
+}
+
+// CompareOdds compares the prices of each market line within the odds, e.g. the odds of a fixture returned by
+// PrematchOddsByFixtureID. When a bookmaker offers more than one price for an outcome the highest price is used. The
+// prices of odds that are stopped or without a valid decimal price are ignored, but their outcomes are still counted
+// so that a market line missing the price of an outcome is not Complete.
+func CompareOdds(odds []PrematchOdds) map[MarketKey]MarketComparison {
+	type selection struct {
+		label  string
+		prices map[int]float64
+	}
+
+	markets := map[MarketKey][]*selection{}
+
+	for i := range odds {
+		book := BookKeyOf(&odds[i])
+		key := MarketKeyOf(book)
+
+		idx := slices.IndexFunc(markets[key], func(s *selection) bool { return s.label == odds[i].Label })
+
+		if idx == -1 {
+			markets[key] = append(markets[key], &selection{label: odds[i].Label, prices: map[int]float64{}})
+			idx = len(markets[key]) - 1
+		}
+
+		price, err := odds[i].DecimalOdds()
+
+		if err != nil || odds[i].Stopped {
+			continue
+		}
+
+		s := markets[key][idx]
+		s.prices[book.BookmakerID] = max(s.prices[book.BookmakerID], price)
+	}
+
+	comparisons := make(map[MarketKey]MarketComparison, len(markets))
+
+	for key, selections := range markets {
+		c := MarketComparison{
+			Key:        key,
+			Selections: make([]SelectionComparison, len(selections)),
+			Complete:   len(selections) > 1,
+		}
+
+		var best, consensus float64
+
+		for i, s := range selections {
+			c.Selections[i] = compareSelection(s.label, s.prices)
+
+			if c.Selections[i].BookmakerCount == 0 {
+				c.Complete = false
+				continue
+			}
+
+			best += 1 / c.Selections[i].BestPrice
+			consensus += 1 / c.Selections[i].Consensus
+		}
+
+		if !c.Complete {
+			comparisons[key] = c
+			continue
+		}
+
+		for i := range c.Selections {
+			c.Selections[i].FairProbability = (1 / c.Selections[i].Consensus) / consensus
+		}
+
+		c.BestOverround = best - 1
+		c.Arbitrage = best < 1
+
+		comparisons[key] = c
+	}
+
+	return comparisons
+}
+
+func compareSelection(label string, prices map[int]float64) SelectionComparison {
+	c := SelectionComparison{Label: label, BookmakerCount: len(prices)}
+
+	if len(prices) == 0 {
+		return c
+	}
+
+	values := make([]float64, 0, len(prices))
+
+	for id, price := range prices {
+		values = append(values, price)
+
+		if price > c.BestPrice || (price == c.BestPrice && id < c.BestBookmakerID) {
+			c.BestPrice = price
+			c.BestBookmakerID = id
+		}
+	}
+
+	c.Consensus = median(values)
+
+	return c
+}
+
+func median(values []float64) float64 {
+	slices.Sort(values)
+
+	n := len(values)
+
+	if n%2 == 1 {
+		return values[n/2]
+	}
+
+	return (values[n/2-1] + values[n/2]) / 2
+}
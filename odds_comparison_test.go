@@ -0,0 +1,150 @@
+package sportmonks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareOdds(t *testing.T) {
+	result := MarketKey{FixtureID: 1, MarketID: 1}
+	goals := MarketKey{FixtureID: 1, MarketID: 80, Total: "2.5"}
+
+	odds := []PrematchOdds{
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "2.00"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Draw", Value: "3.40"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Away", Value: "3.60"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Home", Value: "2.10"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Draw", Value: "3.30"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Away", Value: "3.75"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 23, Label: "Home", Value: "2.10"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 23, Label: "Draw", Value: "3.50"},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Label: "Over", Value: "1.90", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Label: "Under", Value: "1.90", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 9, Label: "Over", Value: "2.15", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 9, Label: "Under", Value: "1.80", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 23, Label: "Under", Value: "2.05", Total: stringPtr("2.5")},
+	}
+
+	t.Run("compares the prices of each market line", func(t *testing.T) {
+		comparisons := CompareOdds(odds)
+
+		assert.Equal(t, 2, len(comparisons))
+
+		c := comparisons[result]
+
+		assert.Equal(t, result, c.Key)
+		assert.Equal(t, []string{"Home", "Draw", "Away"}, labels(c.Selections))
+
+		home := c.Selections[0]
+
+		assert.Equal(t, 2.10, home.BestPrice)
+		assert.Equal(t, 9, home.BestBookmakerID)
+		assert.Equal(t, 2.10, home.Consensus)
+		assert.Equal(t, 3, home.BookmakerCount)
+
+		away := c.Selections[2]
+
+		assert.Equal(t, 3.75, away.BestPrice)
+		assert.Equal(t, (3.60+3.75)/2, away.Consensus)
+		assert.Equal(t, 2, away.BookmakerCount)
+
+		assert.True(t, c.Complete)
+		assert.InDelta(t, 1/2.10+1/3.50+1/3.75-1, c.BestOverround, 1e-9)
+		assert.False(t, c.Arbitrage)
+	})
+
+	t.Run("removes the margin from the consensus probabilities", func(t *testing.T) {
+		comparisons := CompareOdds(odds)
+
+		c := comparisons[result]
+		total := 1/2.10 + 1/3.40 + 1/3.675
+
+		var sum float64
+
+		for _, s := range c.Selections {
+			sum += s.FairProbability
+		}
+
+		assert.InDelta(t, 1, sum, 1e-9)
+		assert.InDelta(t, (1/2.10)/total, c.Selections[0].FairProbability, 1e-9)
+	})
+
+	t.Run("flags arbitrage opportunities", func(t *testing.T) {
+		comparisons := CompareOdds(odds)
+
+		c := comparisons[goals]
+
+		assert.Equal(t, 2.15, c.Selections[0].BestPrice)
+		assert.Equal(t, 2.05, c.Selections[1].BestPrice)
+		assert.Equal(t, 23, c.Selections[1].BestBookmakerID)
+		assert.InDelta(t, 1/2.15+1/2.05-1, c.BestOverround, 1e-9)
+		assert.True(t, c.Arbitrage)
+	})
+
+	t.Run("uses the highest price a bookmaker offers for an outcome", func(t *testing.T) {
+		comparisons := CompareOdds(append(odds[:3:3], PrematchOdds{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "2.20"}))
+
+		home := comparisons[result].Selections[0]
+
+		assert.Equal(t, 2.20, home.BestPrice)
+		assert.Equal(t, 1, home.BookmakerCount)
+	})
+
+	t.Run("ignores malformed and stopped prices", func(t *testing.T) {
+		comparisons := CompareOdds(append(odds[:3:3],
+			PrematchOdds{FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Home", Value: "-"},
+			PrematchOdds{FixtureID: 1, MarketID: 1, BookmakerID: 23, Label: "Home", Value: "2.50", Stopped: true},
+			PrematchOdds{FixtureID: 1, MarketID: 1, BookmakerID: 23, Label: "Draw", Value: "3.50"},
+		))
+
+		c := comparisons[result]
+
+		assert.Equal(t, []string{"Home", "Draw", "Away"}, labels(c.Selections))
+
+		home := c.Selections[0]
+
+		assert.Equal(t, 2.00, home.BestPrice)
+		assert.Equal(t, 2, home.BestBookmakerID)
+		assert.Equal(t, 1, home.BookmakerCount)
+
+		draw := c.Selections[1]
+
+		assert.Equal(t, 3.50, draw.BestPrice)
+		assert.Equal(t, 2, draw.BookmakerCount)
+	})
+
+	t.Run("does not flag arbitrage when an outcome has no price", func(t *testing.T) {
+		comparisons := CompareOdds([]PrematchOdds{
+			{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "2.10"},
+			{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Draw", Value: "3.40", Stopped: true},
+			{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Away", Value: "3.50"},
+		})
+
+		c := comparisons[result]
+
+		assert.Equal(t, []string{"Home", "Draw", "Away"}, labels(c.Selections))
+		assert.Equal(t, 0, c.Selections[1].BookmakerCount)
+		assert.False(t, c.Complete)
+		assert.False(t, c.Arbitrage)
+		assert.Equal(t, 0.0, c.BestOverround)
+		assert.Equal(t, 0.0, c.Selections[0].FairProbability)
+	})
+
+	t.Run("does not flag arbitrage on a market line with a single outcome", func(t *testing.T) {
+		comparisons := CompareOdds([]PrematchOdds{{FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "2.10"}})
+
+		assert.False(t, comparisons[result].Complete)
+		assert.False(t, comparisons[result].Arbitrage)
+	})
+}
+
+func labels(selections []SelectionComparison) []string {
+	var l []string
+
+	for _, s := range selections {
+		l = append(l, s.Label)
+	}
+
+	return l
+}
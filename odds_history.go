@@ -0,0 +1,286 @@
+package sportmonks
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"slices"
+	"sync"
+	"time"
+)
+
+// OddsSnapshot is the decimal price of a selection at the time a snapshot of the odds was taken.
+type OddsSnapshot struct {
+	Key     SelectionKey `json:"key"`
+	Price   float64      `json:"price"`
+	TakenAt time.Time    `json:"taken_at"`
+}
+
+// OddsStore stores OddsSnapshot values recorded by an OddsSnapshotter.
+type OddsStore interface {
+	// Save stores the snapshots.
+	Save(ctx context.Context, snapshots []OddsSnapshot) error
+	// Series returns every snapshot stored for the selection, ordered by the time they were taken.
+	Series(ctx context.Context, key SelectionKey) ([]OddsSnapshot, error)
+	// Keys returns the selections of a fixture with at least one stored snapshot.
+	Keys(ctx context.Context, fixtureID int) ([]SelectionKey, error)
+}
+
+// MemoryOddsStore is an OddsStore holding snapshots in memory. A MemoryOddsStore is safe for concurrent use.
+type MemoryOddsStore struct {
+	mu     sync.Mutex
+	series map[SelectionKey][]OddsSnapshot
+	keys   map[int][]SelectionKey
+}
+
+// NewMemoryOddsStore creates a new empty MemoryOddsStore.
+func NewMemoryOddsStore() *MemoryOddsStore {
+	return &MemoryOddsStore{
+		series: map[SelectionKey][]OddsSnapshot{},
+		keys:   map[int][]SelectionKey{},
+	}
+}
+
+// Save stores the snapshots.
+func (m *MemoryOddsStore) Save(ctx context.Context, snapshots []OddsSnapshot) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.add(snapshots)
+
+	return nil
+}
+
+// Series returns every snapshot stored for the selection, ordered by the time they were taken.
+func (m *MemoryOddsStore) Series(ctx context.Context, key SelectionKey) ([]OddsSnapshot, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.series[key]), nil
+}
+
+// Keys returns the selections of a fixture with at least one stored snapshot, in the order they were first stored.
+func (m *MemoryOddsStore) Keys(ctx context.Context, fixtureID int) ([]SelectionKey, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.keys[fixtureID]), nil
+}
+
+// add stores the snapshots. The caller must hold m.mu.
+func (m *MemoryOddsStore) add(snapshots []OddsSnapshot) {
+	for _, s := range snapshots {
+		series, ok := m.series[s.Key]
+
+		if !ok {
+			m.keys[s.Key.FixtureID] = append(m.keys[s.Key.FixtureID], s.Key)
+		}
+
+		// Snapshots are almost always saved in time order, so the insertion point is found from the end.
+		i := len(series)
+
+		for i > 0 && series[i-1].TakenAt.After(s.TakenAt) {
+			i--
+		}
+
+		m.series[s.Key] = slices.Insert(series, i, s)
+	}
+}
+
+// FileOddsStore is an OddsStore appending snapshots to a file as JSON lines, allowing the history to survive
+// restarts. Stored snapshots are read into memory when the store is opened. A FileOddsStore is safe for concurrent use.
+//
+// No SQLite store is provided, keeping the module free of cgo and third party drivers. Other databases can be used by
+// implementing OddsStore.
+type FileOddsStore struct {
+	memory *MemoryOddsStore
+	file   *os.File
+}
+
+// NewFileOddsStore opens the FileOddsStore at path, creating the file if it does not exist. A final line left
+// incomplete by an interrupted write is discarded, while any other line that cannot be decoded returns an error.
+func NewFileOddsStore(path string) (*FileOddsStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	memory := NewMemoryOddsStore()
+
+	if err := loadOddsFile(file, memory); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &FileOddsStore{memory: memory, file: file}, nil
+}
+
+// loadOddsFile reads the snapshots of the file into memory. A torn final line, one without a trailing newline that
+// cannot be decoded, is truncated so that later snapshots are appended after the last complete line.
+func loadOddsFile(file *os.File, memory *MemoryOddsStore) error {
+	reader := bufio.NewReader(file)
+	var offset int64
+
+	for {
+		line, err := reader.ReadBytes('\n')
+
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		complete := err == nil
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var s OddsSnapshot
+
+			if uerr := json.Unmarshal(line, &s); uerr != nil {
+				if complete {
+					return uerr
+				}
+
+				return file.Truncate(offset)
+			}
+
+			memory.add([]OddsSnapshot{s})
+
+			if !complete {
+				// The last snapshot is whole but its newline was never written.
+				_, werr := file.Write([]byte{'\n'})
+				return werr
+			}
+		}
+
+		if !complete {
+			return nil
+		}
+
+		offset += int64(len(line))
+	}
+}
+
+// Save appends the snapshots to the file and stores them in memory.
+func (f *FileOddsStore) Save(ctx context.Context, snapshots []OddsSnapshot) error {
+	var buf []byte
+
+	for _, s := range snapshots {
+		b, err := json.Marshal(s)
+
+		if err != nil {
+			return err
+		}
+
+		buf = append(append(buf, b...), '\n')
+	}
+
+	f.memory.mu.Lock()
+	defer f.memory.mu.Unlock()
+
+	if _, err := f.file.Write(buf); err != nil {
+		return err
+	}
+
+	f.memory.add(snapshots)
+
+	return nil
+}
+
+// Series returns every snapshot stored for the selection, ordered by the time they were taken.
+func (f *FileOddsStore) Series(ctx context.Context, key SelectionKey) ([]OddsSnapshot, error) {
+	return f.memory.Series(ctx, key)
+}
+
+// Keys returns the selections of a fixture with at least one stored snapshot.
+func (f *FileOddsStore) Keys(ctx context.Context, fixtureID int) ([]SelectionKey, error) {
+	return f.memory.Keys(ctx, fixtureID)
+}
+
+// Close closes the underlying file.
+func (f *FileOddsStore) Close() error {
+	return f.file.Close()
+}
+
+// OddsHistory answers line movement queries using the snapshots held by an OddsStore.
+type OddsHistory struct {
+	Store OddsStore
+}
+
+// Opening returns the first snapshot of the selection. The boolean is false if no snapshot is stored.
+func (h *OddsHistory) Opening(ctx context.Context, key SelectionKey) (OddsSnapshot, bool, error) {
+	series, err := h.Store.Series(ctx, key)
+
+	if err != nil || len(series) == 0 {
+		return OddsSnapshot{}, false, err
+	}
+
+	return series[0], true, nil
+}
+
+// Closing returns the last snapshot of the selection taken before the closing time, typically the kickoff of the
+// fixture. A zero closing time returns the last snapshot stored. The boolean is false if no such snapshot is stored.
+func (h *OddsHistory) Closing(ctx context.Context, key SelectionKey, closing time.Time) (OddsSnapshot, bool, error) {
+	series, err := h.Store.Series(ctx, key)
+
+	if err != nil {
+		return OddsSnapshot{}, false, err
+	}
+
+	for i := len(series) - 1; i >= 0; i-- {
+		if closing.IsZero() || series[i].TakenAt.Before(closing) {
+			return series[i], true, nil
+		}
+	}
+
+	return OddsSnapshot{}, false, nil
+}
+
+// Movement returns the snapshots of the selection at which the price changed, starting with the opening snapshot.
+// Consecutive snapshots holding the same price are omitted.
+func (h *OddsHistory) Movement(ctx context.Context, key SelectionKey) ([]OddsSnapshot, error) {
+	series, err := h.Store.Series(ctx, key)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var movement []OddsSnapshot
+
+	for _, s := range series {
+		if len(movement) == 0 || movement[len(movement)-1].Price != s.Price {
+			movement = append(movement, s)
+		}
+	}
+
+	return movement, nil
+}
+
+// FixtureMovement returns the Movement of every selection of a fixture with at least one stored snapshot, optionally
+// limited to the selections matching keep.
+func (h *OddsHistory) FixtureMovement(ctx context.Context, fixtureID int, keep func(SelectionKey) bool) (map[SelectionKey][]OddsSnapshot, error) {
+	keys, err := h.Store.Keys(ctx, fixtureID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	movements := map[SelectionKey][]OddsSnapshot{}
+
+	for _, k := range keys {
+		if keep != nil && !keep(k) {
+			continue
+		}
+
+		m, err := h.Movement(ctx, k)
+
+		if err != nil {
+			return nil, err
+		}
+
+		movements[k] = m
+	}
+
+	return movements, nil
+}
@@ -0,0 +1,226 @@
+package sportmonks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	historyHome = SelectionKey{BookKey: BookKey{FixtureID: 1, MarketID: 1, BookmakerID: 2}, Label: "Home"}
+	historyAway = SelectionKey{BookKey: BookKey{FixtureID: 1, MarketID: 1, BookmakerID: 2}, Label: "Away"}
+	historyOver = SelectionKey{BookKey: BookKey{FixtureID: 1, MarketID: 80, BookmakerID: 2, Total: "2.5"}, Label: "Over"}
+)
+
+func historyTime(hour int) time.Time {
+	return time.Date(2024, 8, 17, hour, 0, 0, 0, time.UTC)
+}
+
+func historySnapshots() []OddsSnapshot {
+	return []OddsSnapshot{
+		{Key: historyHome, Price: 2.10, TakenAt: historyTime(9)},
+		{Key: historyAway, Price: 3.60, TakenAt: historyTime(9)},
+		{Key: historyHome, Price: 2.10, TakenAt: historyTime(10)},
+		{Key: historyHome, Price: 1.95, TakenAt: historyTime(12)},
+		{Key: historyHome, Price: 2.00, TakenAt: historyTime(11)},
+		{Key: historyHome, Price: 1.80, TakenAt: historyTime(15)},
+		{Key: historyOver, Price: 1.90, TakenAt: historyTime(9)},
+		{Key: SelectionKey{BookKey: BookKey{FixtureID: 2, MarketID: 1, BookmakerID: 2}, Label: "Home"}, Price: 1.5, TakenAt: historyTime(9)},
+	}
+}
+
+func TestOddsStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) OddsStore{
+		"memory": func(t *testing.T) OddsStore {
+			return NewMemoryOddsStore()
+		},
+		"file": func(t *testing.T) OddsStore {
+			store, err := NewFileOddsStore(filepath.Join(t.TempDir(), "odds.jsonl"))
+
+			if err != nil {
+				t.Fatalf("Test failed, expected nil, got %s", err.Error())
+			}
+
+			t.Cleanup(func() { store.Close() })
+
+			return store
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name+" store returns the series of a selection in time order", func(t *testing.T) {
+			store := newStore(t)
+
+			if err := store.Save(context.Background(), historySnapshots()); err != nil {
+				t.Fatalf("Test failed, expected nil, got %s", err.Error())
+			}
+
+			series, err := store.Series(context.Background(), historyHome)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []float64{2.10, 2.10, 2.00, 1.95, 1.80}, prices(series))
+
+			keys, err := store.Keys(context.Background(), 1)
+
+			assert.Nil(t, err)
+			assert.Equal(t, []SelectionKey{historyHome, historyAway, historyOver}, keys)
+
+			series, err = store.Series(context.Background(), SelectionKey{Label: "Draw"})
+
+			assert.Nil(t, err)
+			assert.Empty(t, series)
+		})
+	}
+
+	t.Run("file store reads stored snapshots when reopened", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "odds.jsonl")
+
+		store, err := NewFileOddsStore(path)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, store.Save(context.Background(), historySnapshots()[:4]))
+		assert.Nil(t, store.Close())
+
+		store, err = NewFileOddsStore(path)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		defer store.Close()
+
+		assert.Nil(t, store.Save(context.Background(), historySnapshots()[4:]))
+
+		series, _ := store.Series(context.Background(), historyHome)
+
+		assert.Equal(t, []float64{2.10, 2.10, 2.00, 1.95, 1.80}, prices(series))
+		assert.True(t, historyTime(9).Equal(series[0].TakenAt))
+	})
+
+	t.Run("file store discards a torn final line", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "odds.jsonl")
+
+		store, err := NewFileOddsStore(path)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, store.Save(context.Background(), historySnapshots()[:2]))
+		assert.Nil(t, store.Close())
+
+		file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o644)
+		file.WriteString(`{"key":{"fixture_id":1,"pri`)
+		file.Close()
+
+		store, err = NewFileOddsStore(path)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, store.Save(context.Background(), historySnapshots()[2:4]))
+		assert.Nil(t, store.Close())
+
+		store, err = NewFileOddsStore(path)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		defer store.Close()
+
+		series, _ := store.Series(context.Background(), historyHome)
+
+		assert.Equal(t, []float64{2.10, 2.10, 1.95}, prices(series))
+	})
+
+	t.Run("file store returns an error for a corrupt line before the last", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "odds.jsonl")
+
+		assert.Nil(t, os.WriteFile(path, []byte("{\"price\":\n{\"price\":2.1}\n"), 0o644))
+
+		_, err := NewFileOddsStore(path)
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestOddsHistory(t *testing.T) {
+	store := NewMemoryOddsStore()
+
+	_ = store.Save(context.Background(), historySnapshots())
+
+	history := OddsHistory{Store: store}
+
+	t.Run("returns the opening price", func(t *testing.T) {
+		opening, ok, err := history.Opening(context.Background(), historyHome)
+
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 2.10, opening.Price)
+		assert.Equal(t, historyTime(9), opening.TakenAt)
+	})
+
+	t.Run("returns the closing price before the closing time", func(t *testing.T) {
+		closing, ok, err := history.Closing(context.Background(), historyHome, historyTime(14))
+
+		assert.Nil(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 1.95, closing.Price)
+
+		closing, ok, _ = history.Closing(context.Background(), historyHome, time.Time{})
+
+		assert.True(t, ok)
+		assert.Equal(t, 1.80, closing.Price)
+
+		_, ok, _ = history.Closing(context.Background(), historyHome, historyTime(8))
+
+		assert.False(t, ok)
+	})
+
+	t.Run("an unknown selection has no opening price", func(t *testing.T) {
+		_, ok, err := history.Opening(context.Background(), SelectionKey{Label: "Draw"})
+
+		assert.Nil(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("returns the price movement of a selection", func(t *testing.T) {
+		movement, err := history.Movement(context.Background(), historyHome)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{2.10, 2.00, 1.95, 1.80}, prices(movement))
+		assert.Equal(t, historyTime(11), movement[1].TakenAt)
+	})
+
+	t.Run("returns the price movement of a fixture", func(t *testing.T) {
+		movements, err := history.FixtureMovement(context.Background(), 1, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(movements))
+		assert.Equal(t, []float64{3.60}, prices(movements[historyAway]))
+
+		movements, _ = history.FixtureMovement(context.Background(), 1, func(k SelectionKey) bool { return k.MarketID == 80 })
+
+		assert.Equal(t, 1, len(movements))
+		assert.Equal(t, []float64{1.90}, prices(movements[historyOver]))
+	})
+}
+
+func prices(snapshots []OddsSnapshot) []float64 {
+	var p []float64
+
+	for _, s := range snapshots {
+		p = append(p, s.Price)
+	}
+
+	return p
+}
@@ -0,0 +1,341 @@
+package sportmonks
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// maxFractionDenominator is the largest denominator used when converting decimal odds to fractional odds.
+const maxFractionDenominator = 100
+
+// ErrInvalidOdds is returned when an odds value cannot be parsed or converted.
+type ErrInvalidOdds struct {
+	// Field is the name of the odds field or format holding the value.
+	Field string
+	// Value is the invalid value.
+	Value string
+}
+
+func (e *ErrInvalidOdds) Error() string {
+	return fmt.Sprintf("Invalid %s odds value '%s'", e.Field, e.Value)
+}
+
+// Fraction is a fractional odds price, e.g. 3/2 pays 3 units of profit for every 2 units staked.
+type Fraction struct {
+	Numerator   int64
+	Denominator int64
+}
+
+// ParseFraction parses fractional odds in the form '3/2'. Whole numbers such as '2' are treated as '2/1'. A zero
+// numerator is rejected, as odds always pay out more than the stake.
+func ParseFraction(s string) (Fraction, error) {
+	num, den, found := strings.Cut(strings.TrimSpace(s), "/")
+
+	if !found {
+		den = "1"
+	}
+
+	n, err := strconv.ParseInt(strings.TrimSpace(num), 10, 64)
+
+	if err != nil || n <= 0 {
+		return Fraction{}, &ErrInvalidOdds{Field: "fractional", Value: s}
+	}
+
+	d, err := strconv.ParseInt(strings.TrimSpace(den), 10, 64)
+
+	if err != nil || d <= 0 {
+		return Fraction{}, &ErrInvalidOdds{Field: "fractional", Value: s}
+	}
+
+	return Fraction{Numerator: n, Denominator: d}, nil
+}
+
+func (f Fraction) String() string {
+	return fmt.Sprintf("%d/%d", f.Numerator, f.Denominator)
+}
+
+// Decimal converts the fractional odds to decimal odds.
+func (f Fraction) Decimal() float64 {
+	return 1 + float64(f.Numerator)/float64(f.Denominator)
+}
+
+// ParseDecimal parses decimal odds such as '2.50'. Decimal odds must be greater than 1.
+func ParseDecimal(s string) (float64, error) {
+	d, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+
+	if err != nil || math.IsNaN(d) || math.IsInf(d, 0) || d <= 1 {
+		return 0, &ErrInvalidOdds{Field: "decimal", Value: s}
+	}
+
+	return d, nil
+}
+
+// ParseAmerican parses american odds such as '+150' or '-200'. Values between -100 and 100 are invalid.
+func ParseAmerican(s string) (int, error) {
+	a, err := strconv.Atoi(strings.TrimSpace(s))
+
+	if err != nil || (a > -100 && a < 100) {
+		return 0, &ErrInvalidOdds{Field: "american", Value: s}
+	}
+
+	return a, nil
+}
+
+// ParseProbability parses a probability such as '40%' or '0.4' into a fraction between 0 and 1.
+func ParseProbability(s string) (float64, error) {
+	v := strings.TrimSpace(s)
+	percent := strings.HasSuffix(v, "%")
+
+	p, err := strconv.ParseFloat(strings.TrimSuffix(v, "%"), 64)
+
+	if err != nil || math.IsNaN(p) {
+		return 0, &ErrInvalidOdds{Field: "probability", Value: s}
+	}
+
+	if percent {
+		p /= 100
+	}
+
+	if p < 0 || p > 1 {
+		return 0, &ErrInvalidOdds{Field: "probability", Value: s}
+	}
+
+	return p, nil
+}
+
+// DecimalToFractional converts decimal odds to the closest fractional odds with a denominator of at most 100. Odds
+// shorter than 1/100, e.g. 1.001, are returned as 1/100 as a fraction with a zero numerator is not valid odds.
+func DecimalToFractional(decimal float64) (Fraction, error) {
+	if err := validDecimal(decimal); err != nil {
+		return Fraction{}, err
+	}
+
+	f := approximateFraction(decimal - 1)
+
+	if f.Numerator == 0 {
+		f = Fraction{Numerator: 1, Denominator: maxFractionDenominator}
+	}
+
+	return f, nil
+}
+
+// DecimalToAmerican converts decimal odds to american odds, rounded to the nearest whole number.
+func DecimalToAmerican(decimal float64) (int, error) {
+	if err := validDecimal(decimal); err != nil {
+		return 0, err
+	}
+
+	if decimal >= 2 {
+		return int(math.Round((decimal - 1) * 100)), nil
+	}
+
+	return int(math.Round(-100 / (decimal - 1))), nil
+}
+
+// AmericanToDecimal converts american odds to decimal odds.
+func AmericanToDecimal(american int) (float64, error) {
+	if american > -100 && american < 100 {
+		return 0, &ErrInvalidOdds{Field: "american", Value: strconv.Itoa(american)}
+	}
+
+	if american > 0 {
+		return 1 + float64(american)/100, nil
+	}
+
+	return 1 + 100/float64(-american), nil
+}
+
+// ImpliedProbability returns the probability implied by decimal odds, including the bookmaker margin.
+func ImpliedProbability(decimal float64) (float64, error) {
+	if err := validDecimal(decimal); err != nil {
+		return 0, err
+	}
+
+	return 1 / decimal, nil
+}
+
+// DecimalOdds returns the Value of the odds as decimal odds, falling back to Dp3 when Value is empty.
+func (o *PrematchOdds) DecimalOdds() (float64, error) {
+	if o.Value == "" && o.Dp3 != "" {
+		return ParseDecimal(o.Dp3)
+	}
+
+	return ParseDecimal(o.Value)
+}
+
+// FractionalOdds returns the Fractional value of the odds.
+func (o *PrematchOdds) FractionalOdds() (Fraction, error) {
+	return ParseFraction(o.Fractional)
+}
+
+// AmericanOdds returns the American value of the odds.
+func (o *PrematchOdds) AmericanOdds() (int, error) {
+	return ParseAmerican(o.American)
+}
+
+// ImpliedProbability returns the Probability of the odds as a fraction between 0 and 1, calculating it from the
+// decimal odds when Probability is empty.
+func (o *PrematchOdds) ImpliedProbability() (float64, error) {
+	if o.Probability != "" {
+		return ParseProbability(o.Probability)
+	}
+
+	d, err := o.DecimalOdds()
+
+	if err != nil {
+		return 0, err
+	}
+
+	return ImpliedProbability(d)
+}
+
+// TotalValue returns the Total line of the odds, e.g. 2.5 for an over/under 2.5 goals market.
+func (o *PrematchOdds) TotalValue() (float64, error) {
+	return parseLine("total", o.Total)
+}
+
+// HandicapValue returns the Handicap line of the odds, e.g. -1.5.
+func (o *PrematchOdds) HandicapValue() (float64, error) {
+	return parseLine("handicap", o.Handicap)
+}
+
+// BookKey identifies the odds offered by a bookmaker on a single market line of a fixture, which together form a book.
+type BookKey struct {
+	FixtureID   int
+	MarketID    int
+	BookmakerID int
+	Total       string
+	Handicap    string
+}
+
+// BookKeyOf returns the BookKey of the odds.
+func BookKeyOf(o *PrematchOdds) BookKey {
+	k := BookKey{FixtureID: o.FixtureID, MarketID: o.MarketID, BookmakerID: o.BookmakerID}
+
+	if o.Total != nil {
+		k.Total = *o.Total
+	}
+
+	if o.Handicap != nil {
+		k.Handicap = *o.Handicap
+	}
+
+	return k
+}
+
+// GroupOdds groups odds into books by market line and bookmaker, retaining the order of the odds within each book.
+func GroupOdds(odds []PrematchOdds) map[BookKey][]PrematchOdds {
+	books := map[BookKey][]PrematchOdds{}
+
+	for _, o := range odds {
+		k := BookKeyOf(&o)
+		books[k] = append(books[k], o)
+	}
+
+	return books
+}
+
+// Overround returns the amount by which the implied probabilities of the outcomes of a book exceed 1, e.g. 0.05 for a
+// book totalling 105%. The odds should hold every outcome of a single market line offered by one bookmaker.
+func Overround(odds []PrematchOdds) (float64, error) {
+	if len(odds) == 0 {
+		return 0, nil
+	}
+
+	var total float64
+
+	for _, o := range odds {
+		d, err := o.DecimalOdds()
+
+		if err != nil {
+			return 0, err
+		}
+
+		total += 1 / d
+	}
+
+	return total - 1, nil
+}
+
+// Margin returns the share of the stakes on a book that the bookmaker expects to keep, e.g. 0.0476 for a book
+// totalling 105%.
+func Margin(odds []PrematchOdds) (float64, error) {
+	overround, err := Overround(odds)
+
+	if err != nil || len(odds) == 0 {
+		return 0, err
+	}
+
+	return overround / (1 + overround), nil
+}
+
+// Overrounds returns the Overround of each book within the odds, grouped using GroupOdds.
+func Overrounds(odds []PrematchOdds) (map[BookKey]float64, error) {
+	overrounds := map[BookKey]float64{}
+
+	for k, book := range GroupOdds(odds) {
+		o, err := Overround(book)
+
+		if err != nil {
+			return nil, err
+		}
+
+		overrounds[k] = o
+	}
+
+	return overrounds, nil
+}
+
+func validDecimal(decimal float64) error {
+	if math.IsNaN(decimal) || math.IsInf(decimal, 0) || decimal <= 1 {
+		return &ErrInvalidOdds{Field: "decimal", Value: strconv.FormatFloat(decimal, 'f', -1, 64)}
+	}
+
+	return nil
+}
+
+func parseLine(field string, value *string) (float64, error) {
+	if value == nil {
+		return 0, &ErrInvalidOdds{Field: field, Value: "null"}
+	}
+
+	v, err := strconv.ParseFloat(strings.TrimSpace(*value), 64)
+
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, &ErrInvalidOdds{Field: field, Value: *value}
+	}
+
+	return v, nil
+}
+
+// approximateFraction returns the closest fraction to v with a denominator no greater than maxFractionDenominator,
+// using the continued fraction expansion of v.
+func approximateFraction(v float64) Fraction {
+	// The convergents h/k of the expansion, starting from the conventional seeds 0/1 and 1/0.
+	h0, h1 := int64(0), int64(1)
+	k0, k1 := int64(1), int64(0)
+	x := v
+
+	for {
+		a := int64(math.Floor(x))
+		h2, k2 := a*h1+h0, a*k1+k0
+
+		if k2 > maxFractionDenominator {
+			break
+		}
+
+		h0, h1, k0, k1 = h1, h2, k1, k2
+
+		frac := x - float64(a)
+
+		if frac < 1e-9 {
+			break
+		}
+
+		x = 1 / frac
+	}
+
+	return Fraction{Numerator: h1, Denominator: k1}
+}
@@ -0,0 +1,272 @@
+package sportmonks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestPrematchOdds_Accessors(t *testing.T) {
+	t.Run("parses each odds format", func(t *testing.T) {
+		odds := PrematchOdds{
+			Value:       "2.5",
+			Probability: "40%",
+			Dp3:         "2.500",
+			Fractional:  "3/2",
+			American:    "+150",
+			Total:       stringPtr("2.5"),
+			Handicap:    stringPtr("-1.5"),
+		}
+
+		decimal, err := odds.DecimalOdds()
+		assert.Nil(t, err)
+		assert.Equal(t, 2.5, decimal)
+
+		fraction, err := odds.FractionalOdds()
+		assert.Nil(t, err)
+		assert.Equal(t, Fraction{Numerator: 3, Denominator: 2}, fraction)
+		assert.Equal(t, "3/2", fraction.String())
+		assert.Equal(t, 2.5, fraction.Decimal())
+
+		american, err := odds.AmericanOdds()
+		assert.Nil(t, err)
+		assert.Equal(t, 150, american)
+
+		probability, err := odds.ImpliedProbability()
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.4, probability, 1e-9)
+
+		total, err := odds.TotalValue()
+		assert.Nil(t, err)
+		assert.Equal(t, 2.5, total)
+
+		handicap, err := odds.HandicapValue()
+		assert.Nil(t, err)
+		assert.Equal(t, -1.5, handicap)
+	})
+
+	t.Run("falls back to dp3 and the decimal odds", func(t *testing.T) {
+		odds := PrematchOdds{Dp3: "4.000"}
+
+		decimal, err := odds.DecimalOdds()
+		assert.Nil(t, err)
+		assert.Equal(t, 4.0, decimal)
+
+		probability, err := odds.ImpliedProbability()
+		assert.Nil(t, err)
+		assert.Equal(t, 0.25, probability)
+	})
+
+	t.Run("returns an error for malformed values", func(t *testing.T) {
+		odds := PrematchOdds{
+			Value:       "evens",
+			Probability: "140%",
+			Fractional:  "3/0",
+			American:    "+50",
+			Total:       stringPtr("over"),
+		}
+
+		var invalid *ErrInvalidOdds
+
+		_, err := odds.DecimalOdds()
+		assert.True(t, errors.As(err, &invalid))
+		assert.Equal(t, "Invalid decimal odds value 'evens'", err.Error())
+
+		_, err = odds.FractionalOdds()
+		assert.True(t, errors.As(err, &invalid))
+
+		_, err = odds.AmericanOdds()
+		assert.True(t, errors.As(err, &invalid))
+
+		_, err = odds.ImpliedProbability()
+		assert.True(t, errors.As(err, &invalid))
+
+		_, err = odds.TotalValue()
+		assert.True(t, errors.As(err, &invalid))
+
+		_, err = odds.HandicapValue()
+		assert.Equal(t, "Invalid handicap odds value 'null'", err.Error())
+	})
+}
+
+func TestOddsParsing(t *testing.T) {
+	t.Run("parses valid values", func(t *testing.T) {
+		d, err := ParseDecimal(" 1.91 ")
+		assert.Nil(t, err)
+		assert.Equal(t, 1.91, d)
+
+		f, err := ParseFraction("2")
+		assert.Nil(t, err)
+		assert.Equal(t, Fraction{Numerator: 2, Denominator: 1}, f)
+
+		a, err := ParseAmerican("-200")
+		assert.Nil(t, err)
+		assert.Equal(t, -200, a)
+
+		p, err := ParseProbability("0.55")
+		assert.Nil(t, err)
+		assert.Equal(t, 0.55, p)
+	})
+
+	t.Run("rejects malformed values", func(t *testing.T) {
+		for _, v := range []string{"", "abc", "1", "0.5", "-2", "NaN", "Inf"} {
+			_, err := ParseDecimal(v)
+			assert.NotNil(t, err, v)
+		}
+
+		for _, v := range []string{"", "3/", "/2", "a/b", "-1/2", "0/1", "0", "3/0", "3/-2", "1.5/1"} {
+			_, err := ParseFraction(v)
+			assert.NotNil(t, err, v)
+		}
+
+		for _, v := range []string{"", "+", "99", "-99", "0", "1.5", "+1.5e2"} {
+			_, err := ParseAmerican(v)
+			assert.NotNil(t, err, v)
+		}
+
+		for _, v := range []string{"", "%", "abc%", "101%", "-1%", "1.5", "NaN"} {
+			_, err := ParseProbability(v)
+			assert.NotNil(t, err, v)
+		}
+	})
+}
+
+func TestOddsConversion(t *testing.T) {
+	t.Run("converts decimal odds to fractional odds", func(t *testing.T) {
+		tests := []struct {
+			decimal float64
+			want    Fraction
+		}{
+			{2.5, Fraction{3, 2}},
+			{2.0, Fraction{1, 1}},
+			{1.5, Fraction{1, 2}},
+			{1.909, Fraction{10, 11}},
+			{11.0, Fraction{10, 1}},
+			{1.01, Fraction{1, 100}},
+		}
+
+		for _, tc := range tests {
+			f, err := DecimalToFractional(tc.decimal)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.want, f, tc.decimal)
+		}
+	})
+
+	t.Run("converts very short decimal odds to the shortest valid fraction", func(t *testing.T) {
+		f, err := DecimalToFractional(1.001)
+
+		assert.Nil(t, err)
+		assert.Equal(t, Fraction{Numerator: 1, Denominator: 100}, f)
+
+		parsed, err := ParseFraction(f.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, f, parsed)
+	})
+
+	t.Run("converts between decimal and american odds", func(t *testing.T) {
+		tests := []struct {
+			decimal  float64
+			american int
+		}{
+			{2.5, 150},
+			{2.0, 100},
+			{1.5, -200},
+			{1.25, -400},
+			{11.0, 1000},
+		}
+
+		for _, tc := range tests {
+			a, err := DecimalToAmerican(tc.decimal)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.american, a, tc.decimal)
+
+			d, err := AmericanToDecimal(tc.american)
+			assert.Nil(t, err)
+			assert.InDelta(t, tc.decimal, d, 1e-9, tc.american)
+		}
+	})
+
+	t.Run("rejects invalid odds", func(t *testing.T) {
+		_, err := DecimalToFractional(1)
+		assert.NotNil(t, err)
+
+		_, err = DecimalToAmerican(0.5)
+		assert.NotNil(t, err)
+
+		_, err = AmericanToDecimal(50)
+		assert.NotNil(t, err)
+
+		_, err = ImpliedProbability(-3)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("calculates the implied probability", func(t *testing.T) {
+		p, err := ImpliedProbability(4)
+		assert.Nil(t, err)
+		assert.Equal(t, 0.25, p)
+	})
+}
+
+func TestOverround(t *testing.T) {
+	odds := []PrematchOdds{
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Value: "2.00"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Value: "4.00"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 2, Value: "3.50"},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Value: "1.90", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Value: "1.90", Total: stringPtr("2.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Value: "1.40", Total: stringPtr("1.5")},
+		{FixtureID: 1, MarketID: 80, BookmakerID: 2, Value: "2.75", Total: stringPtr("1.5")},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Value: "2.10"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Value: "3.80"},
+		{FixtureID: 1, MarketID: 1, BookmakerID: 9, Value: "3.60"},
+	}
+
+	t.Run("groups odds by market line and bookmaker", func(t *testing.T) {
+		books := GroupOdds(odds)
+
+		assert.Equal(t, 4, len(books))
+		assert.Equal(t, 3, len(books[BookKey{FixtureID: 1, MarketID: 1, BookmakerID: 2}]))
+		assert.Equal(t, 2, len(books[BookKey{FixtureID: 1, MarketID: 80, BookmakerID: 2, Total: "2.5"}]))
+	})
+
+	t.Run("calculates the overround and margin of a book", func(t *testing.T) {
+		book := odds[:3]
+
+		overround, err := Overround(book)
+		assert.Nil(t, err)
+		assert.InDelta(t, 0.5+0.25+1/3.5-1, overround, 1e-9)
+
+		margin, err := Margin(book)
+		assert.Nil(t, err)
+		assert.InDelta(t, overround/(1+overround), margin, 1e-9)
+	})
+
+	t.Run("calculates the overround of every book", func(t *testing.T) {
+		overrounds, err := Overrounds(odds)
+		assert.Nil(t, err)
+
+		assert.Equal(t, 4, len(overrounds))
+		assert.InDelta(t, 2/1.9-1, overrounds[BookKey{FixtureID: 1, MarketID: 80, BookmakerID: 2, Total: "2.5"}], 1e-9)
+		assert.InDelta(t, 1/1.4+1/2.75-1, overrounds[BookKey{FixtureID: 1, MarketID: 80, BookmakerID: 2, Total: "1.5"}], 1e-9)
+	})
+
+	t.Run("returns an error for a malformed price", func(t *testing.T) {
+		_, err := Overrounds(append(odds, PrematchOdds{FixtureID: 1, MarketID: 1, BookmakerID: 9, Value: "-"}))
+
+		var invalid *ErrInvalidOdds
+
+		assert.True(t, errors.As(err, &invalid))
+	})
+
+	t.Run("an empty book has no overround", func(t *testing.T) {
+		overround, err := Overround(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, overround)
+	})
+}
@@ -0,0 +1,130 @@
+package sportmonks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// defaultOddsSnapshotInterval is the time between snapshots when an OddsSnapshotter is not given a positive interval.
+const defaultOddsSnapshotInterval = 5 * time.Minute
+
+// OddsSnapshotter periodically records the pre-match odds of a set of fixtures in an OddsStore, building the price
+// history used by OddsHistory.
+type OddsSnapshotter struct {
+	// Interval is the time between each snapshot. A non-positive interval snapshots every 5 minutes.
+	Interval time.Duration
+	// FixtureIDs are the fixtures whose odds are recorded.
+	FixtureIDs []int
+	// Query is used to filter the odds recorded, e.g. to a set of markets or bookmakers.
+	Query  *Query
+	client Client
+	store  OddsStore
+	now    func() time.Time
+}
+
+// NewOddsSnapshotter creates a new OddsSnapshotter recording the odds of the fixtures in the store on the given
+// interval. A non-positive interval snapshots every 5 minutes.
+func NewOddsSnapshotter(client Client, store OddsStore, interval time.Duration, fixtureIDs ...int) *OddsSnapshotter {
+	if interval <= 0 {
+		interval = defaultOddsSnapshotInterval
+	}
+
+	return &OddsSnapshotter{
+		Interval:   interval,
+		FixtureIDs: fixtureIDs,
+		client:     client,
+		store:      store,
+		now:        time.Now,
+	}
+}
+
+// Snapshot fetches every page of the pre-match odds of each fixture and saves the decimal price of each selection. A
+// fixture that fails does not stop the remaining fixtures being snapshotted, and the errors of every failed fixture
+// are returned joined. Odds that are stopped or without a valid decimal price are ignored, so the history only holds
+// prices that were available to bet on.
+func (s *OddsSnapshotter) Snapshot(ctx context.Context) error {
+	var errs []error
+
+	for _, id := range s.FixtureIDs {
+		if ctx.Err() != nil {
+			errs = append(errs, ctx.Err())
+			break
+		}
+
+		if err := s.snapshotFixture(ctx, id); err != nil {
+			errs = append(errs, fmt.Errorf("fixture %d: %w", id, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *OddsSnapshotter) snapshotFixture(ctx context.Context, id int) error {
+	odds, err := Collect(s.client.PrematchOddsByFixtureIDIter(ctx, id, s.Query), 0)
+
+	if err != nil {
+		return err
+	}
+
+	taken := s.now()
+	snapshots := make([]OddsSnapshot, 0, len(odds))
+
+	for i := range odds {
+		if odds[i].Stopped {
+			continue
+		}
+
+		price, err := odds[i].DecimalOdds()
+
+		if err != nil {
+			continue
+		}
+
+		snapshots = append(snapshots, OddsSnapshot{
+			Key:     SelectionKey{BookKey: BookKeyOf(&odds[i]), Label: odds[i].Label},
+			Price:   price,
+			TakenAt: taken,
+		})
+	}
+
+	return s.store.Save(ctx, snapshots)
+}
+
+// Start takes a snapshot immediately and then on every interval, returning a channel of the errors of failed
+// snapshots. A failed snapshot does not stop later snapshots, and errors are dropped rather than waiting when the
+// channel is not being received from. Snapshotting stops and the channel is closed once ctx is cancelled.
+func (s *OddsSnapshotter) Start(ctx context.Context) <-chan error {
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+
+		interval := s.Interval
+
+		if interval <= 0 {
+			interval = defaultOddsSnapshotInterval
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.Snapshot(ctx); err != nil && ctx.Err() == nil {
+				select {
+				case errs <- err:
+				default:
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return errs
+}
@@ -0,0 +1,205 @@
+package sportmonks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// oddsSnapshotterServer serves each pre-match odds response in turn, repeating the final response once all have been
+// served.
+func oddsSnapshotterServer(responses ...func() *http.Response) *http.Client {
+	var mu sync.Mutex
+	calls := 0
+
+	return newTestClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		r := responses[min(calls, len(responses)-1)]
+		calls++
+
+		return r()
+	})
+}
+
+func okOdds(prices ...string) func() *http.Response {
+	return func() *http.Response {
+		return stringResponse(200, oddsBody(prices...))
+	}
+}
+
+func TestOddsSnapshotter(t *testing.T) {
+	t.Run("saves the price of each selection", func(t *testing.T) {
+		store := NewMemoryOddsStore()
+
+		server := oddsSnapshotterServer(okOdds("2.10", "3.40", "-"), okOdds("1.95", "3.40", "4.00"))
+
+		snapshotter := NewOddsSnapshotter(newTestHTTPClient(server), store, time.Minute, 19134492)
+
+		hour := 9
+		snapshotter.now = func() time.Time {
+			return historyTime(hour)
+		}
+
+		assert.Nil(t, snapshotter.Snapshot(context.Background()))
+
+		hour = 10
+
+		assert.Nil(t, snapshotter.Snapshot(context.Background()))
+
+		home := SelectionKey{BookKey: BookKey{FixtureID: 19134492, MarketID: 1, BookmakerID: 2}, Label: "Home"}
+		away := SelectionKey{BookKey: BookKey{FixtureID: 19134492, MarketID: 1, BookmakerID: 2}, Label: "Away"}
+
+		series, _ := store.Series(context.Background(), home)
+
+		assert.Equal(t, []float64{2.10, 1.95}, prices(series))
+		assert.Equal(t, historyTime(10), series[1].TakenAt)
+
+		series, _ = store.Series(context.Background(), away)
+
+		assert.Equal(t, []float64{4.00}, prices(series))
+	})
+
+	t.Run("sends the errors of failed snapshots and keeps snapshotting", func(t *testing.T) {
+		store := NewMemoryOddsStore()
+
+		server := oddsSnapshotterServer(
+			func() *http.Response { return stringResponse(400, errorResponse) },
+			okOdds("2.10"),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errs := NewOddsSnapshotter(newTestHTTPClient(server), store, time.Millisecond, 19134492).Start(ctx)
+
+		select {
+		case err := <-errs:
+			var bad *ErrBadStatusCode
+
+			assert.True(t, errors.As(err, &bad))
+		case <-time.After(time.Second):
+			t.Fatal("Test failed, expected an error")
+		}
+
+		assert.Eventually(t, func() bool {
+			keys, _ := store.Keys(context.Background(), 19134492)
+
+			return len(keys) == 1
+		}, time.Second, time.Millisecond)
+
+		cancel()
+
+		for range errs {
+		}
+	})
+
+	t.Run("keeps snapshotting when errors are not received", func(t *testing.T) {
+		store := NewMemoryOddsStore()
+
+		var mu sync.Mutex
+		calls := 0
+
+		server := newTestClient(func(req *http.Request) *http.Response {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls++
+
+			if calls <= 3 {
+				return stringResponse(400, errorResponse)
+			}
+
+			return stringResponse(200, oddsBody("2.10"))
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errs := NewOddsSnapshotter(newTestHTTPClient(server), store, time.Millisecond, 19134492).Start(ctx)
+
+		assert.Eventually(t, func() bool {
+			keys, _ := store.Keys(context.Background(), 19134492)
+
+			return len(keys) == 1
+		}, time.Second, time.Millisecond)
+
+		cancel()
+
+		for range errs {
+		}
+	})
+
+	t.Run("defaults a non-positive interval", func(t *testing.T) {
+		snapshotter := NewOddsSnapshotter(newTestHTTPClient(oddsSnapshotterServer(okOdds("2.10"))), NewMemoryOddsStore(), 0)
+
+		assert.Equal(t, defaultOddsSnapshotInterval, snapshotter.Interval)
+	})
+
+	t.Run("snapshots the remaining fixtures when one fails", func(t *testing.T) {
+		store := NewMemoryOddsStore()
+
+		server := newTestClient(func(req *http.Request) *http.Response {
+			if strings.HasSuffix(req.URL.Path, "/fixtures/1") {
+				return stringResponse(404, errorResponse)
+			}
+
+			return stringResponse(200, oddsBody("2.10"))
+		})
+
+		snapshotter := NewOddsSnapshotter(newTestHTTPClient(server), store, time.Minute, 1, 19134492)
+
+		err := snapshotter.Snapshot(context.Background())
+
+		assert.True(t, strings.Contains(err.Error(), "fixture 1:"))
+
+		keys, _ := store.Keys(context.Background(), 19134492)
+
+		assert.Equal(t, 1, len(keys))
+	})
+
+	t.Run("ignores stopped prices", func(t *testing.T) {
+		store := NewMemoryOddsStore()
+
+		server := oddsSnapshotterServer(func() *http.Response {
+			return stringResponse(200, `{"data": [
+				{"id": 1, "fixture_id": 19134492, "market_id": 1, "bookmaker_id": 2, "label": "Home", "value": "2.10"},
+				{"id": 2, "fixture_id": 19134492, "market_id": 1, "bookmaker_id": 2, "label": "Draw", "value": "3.40", "stopped": true}
+			]}`)
+		})
+
+		snapshotter := NewOddsSnapshotter(newTestHTTPClient(server), store, time.Minute, 19134492)
+
+		assert.Nil(t, snapshotter.Snapshot(context.Background()))
+
+		keys, _ := store.Keys(context.Background(), 19134492)
+
+		assert.Equal(t, 1, len(keys))
+		assert.Equal(t, "Home", keys[0].Label)
+	})
+
+	t.Run("returns the error of a failed save", func(t *testing.T) {
+		server := oddsSnapshotterServer(okOdds("2.10"))
+
+		snapshotter := NewOddsSnapshotter(newTestHTTPClient(server), failingOddsStore{}, time.Minute, 19134492)
+
+		err := snapshotter.Snapshot(context.Background())
+
+		assert.True(t, strings.Contains(err.Error(), "disk full"))
+	})
+}
+
+type failingOddsStore struct {
+	OddsStore
+}
+
+func (failingOddsStore) Save(ctx context.Context, snapshots []OddsSnapshot) error {
+	return errors.New("disk full")
+}
@@ -59,14 +59,14 @@ var prematchOddsResponse = `{
 }`
 
 func TestAllPrematchOdds(t *testing.T) {
-	url := defaultBaseURL + "/football/odds/pre-match?api_token=api-key&include=&page=1"
+	url := defaultBaseURL + "/football/odds/pre-match?api_token=api-key&page=1"
 
 	t.Run("returns prematch odds struct slice", func(t *testing.T) {
 		server := mockResponseServer(t, prematchOddsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		odds, _, err := client.AllPrematchOdds(context.Background(), []string{}, map[string][]int{}, 1)
+		odds, _, err := client.AllPrematchOdds(context.Background(), nil, 1)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -80,7 +80,7 @@ func TestAllPrematchOdds(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		odds, _, err := client.AllPrematchOdds(context.Background(), []string{}, map[string][]int{}, 1)
+		odds, _, err := client.AllPrematchOdds(context.Background(), nil, 1)
 
 		if odds != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", odds)
@@ -91,14 +91,14 @@ func TestAllPrematchOdds(t *testing.T) {
 }
 
 func TestPrematchOddsByFixtureID(t *testing.T) {
-	url := defaultBaseURL + "/football/odds/pre-match/fixtures/11867289?api_token=api-key&include=&page=1"
+	url := defaultBaseURL + "/football/odds/pre-match/fixtures/11867289?api_token=api-key&page=1"
 
 	t.Run("returns prematch odds struct slice", func(t *testing.T) {
 		server := mockResponseServer(t, prematchOddsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		odds, _, err := client.PrematchOddsByFixtureID(context.Background(), 11867289, []string{}, map[string][]int{}, 1)
+		odds, _, err := client.PrematchOddsByFixtureID(context.Background(), 11867289, nil, 1)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -112,7 +112,7 @@ func TestPrematchOddsByFixtureID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		odds, _, err := client.PrematchOddsByFixtureID(context.Background(), 11867289, []string{}, map[string][]int{}, 1)
+		odds, _, err := client.PrematchOddsByFixtureID(context.Background(), 11867289, nil, 1)
 
 		if odds != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", odds)
@@ -143,8 +143,8 @@ func assertPrematchOdds(t *testing.T, odds *PrematchOdds) {
 	assert.Equal(t, "Team A vs Team B", odds.Participants)
 	assert.Equal(t, "2023-10-01T12:00:00Z", odds.CreatedAt)
 	assert.Nil(t, odds.OriginalLabel)
-	assert.Equal(t, "2023-10-01T12:00:00Z", odds.LatestBookmakerUpdate)
+	assert.Equal(t, "2023-10-01T12:00:00Z", odds.LatestBookmakerUpdate.String())
 	assert.Equal(t, 11867289, odds.Fixture.ID)
-	assert.Equal(t, "2023-10-01T12:00:00Z", odds.Fixture.StartingAt)
+	assert.Equal(t, "2023-10-01T12:00:00Z", odds.Fixture.StartingAt.String())
 	assert.Equal(t, "finished", odds.Fixture.FixtureState.Name)
 }
@@ -0,0 +1,193 @@
+package sportmonks
+
+import (
+	"context"
+	"time"
+)
+
+// SelectionKey identifies a single outcome of a market line offered by a bookmaker on a fixture, e.g. 'Home' of the
+// fulltime result market.
+type SelectionKey struct {
+	BookKey
+	Label string
+}
+
+// OddsMovement is a change in the price of a selection detected by an OddsTracker. Prices are decimal odds.
+type OddsMovement struct {
+	Key SelectionKey
+	// Opening is the first price seen for the selection.
+	Opening float64
+	// Previous is the price before the movement.
+	Previous float64
+	// Current is the price after the movement.
+	Current float64
+	// Odds holds the latest odds for the selection.
+	Odds *InplayOdds
+	// Err is populated when a request fails, in which case the remaining fields are empty.
+	Err error
+}
+
+// Change returns the difference between the Current and Previous price, which is negative when the price shortened.
+func (m OddsMovement) Change() float64 {
+	return m.Current - m.Previous
+}
+
+// defaultOddsTrackerInterval is the time between polls when an OddsTracker is not given a positive interval. The
+// latest in play odds endpoint returns odds updated within the last 10 seconds, so polling less often misses changes.
+const defaultOddsTrackerInterval = 5 * time.Second
+
+// oddsTrackerExpiry is how long an OddsTracker remembers a selection without seeing its odds, so the prices of
+// fixtures that have finished or left the feed are forgotten.
+const oddsTrackerExpiry = time.Hour
+
+// OddsTracker polls the latest in play odds endpoint and reports price movements of each selection as OddsMovement
+// values.
+type OddsTracker struct {
+	// Interval is the time between each poll of the latest in play odds endpoint. A non-positive interval polls every
+	// 5 seconds.
+	Interval time.Duration
+	// Query is used to filter the odds returned on each poll, e.g. to a set of markets or bookmakers.
+	Query  *Query
+	client Client
+	now    func() time.Time
+}
+
+// NewOddsTracker creates a new OddsTracker polling using the client on the given interval. A non-positive interval
+// polls every 5 seconds.
+func NewOddsTracker(client Client, interval time.Duration) *OddsTracker {
+	if interval <= 0 {
+		interval = defaultOddsTrackerInterval
+	}
+
+	return &OddsTracker{
+		Interval: interval,
+		client:   client,
+		now:      time.Now,
+	}
+}
+
+// Start begins polling and returns a channel of the detected price movements. Every in play odds price is fetched
+// first to record the opening prices, so only subsequent movements are reported. Odds that are suspended, stopped or
+// without a valid decimal price are ignored, and selections whose odds are not seen for an hour are forgotten. Request
+// failures are sent as an OddsMovement holding the error and polling continues. Polling stops and the channel is
+// closed once ctx is cancelled.
+func (t *OddsTracker) Start(ctx context.Context) <-chan OddsMovement {
+	movements := make(chan OddsMovement)
+
+	go t.run(ctx, movements)
+
+	return movements
+}
+
+type selectionPrices struct {
+	opening  float64
+	current  float64
+	lastSeen time.Time
+}
+
+func (t *OddsTracker) run(ctx context.Context, movements chan<- OddsMovement) {
+	defer close(movements)
+
+	interval := t.Interval
+
+	if interval <= 0 {
+		interval = defaultOddsTrackerInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	prices := map[SelectionKey]*selectionPrices{}
+	primed := false
+
+	for {
+		var odds []InplayOdds
+		var err error
+
+		if primed {
+			odds, _, err = t.client.LatestInplayOdds(ctx, t.Query)
+		} else {
+			odds, err = Collect(t.client.AllInplayOddsIter(ctx, t.Query), 0)
+		}
+
+		if err != nil && ctx.Err() == nil {
+			if !sendMovement(ctx, movements, OddsMovement{Err: err}) {
+				return
+			}
+		}
+
+		now := t.now()
+
+		for i := range odds {
+			o := &odds[i]
+			key := selectionKeyOf(o)
+			p, ok := prices[key]
+
+			if ok {
+				p.lastSeen = now
+			}
+
+			price, perr := o.DecimalOdds()
+
+			if perr != nil || o.Suspended || o.Stopped {
+				continue
+			}
+
+			if !ok {
+				prices[key] = &selectionPrices{opening: price, current: price, lastSeen: now}
+				continue
+			}
+
+			if p.current == price {
+				continue
+			}
+
+			m := OddsMovement{Key: key, Opening: p.opening, Previous: p.current, Current: price, Odds: o}
+			p.current = price
+
+			if primed && !sendMovement(ctx, movements, m) {
+				return
+			}
+		}
+
+		primed = primed || err == nil
+
+		for key, p := range prices {
+			if now.Sub(p.lastSeen) > oddsTrackerExpiry {
+				delete(prices, key)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func sendMovement(ctx context.Context, movements chan<- OddsMovement, m OddsMovement) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case movements <- m:
+		return true
+	}
+}
+
+func selectionKeyOf(o *InplayOdds) SelectionKey {
+	k := SelectionKey{
+		BookKey: BookKey{FixtureID: o.FixtureID, MarketID: o.MarketID, BookmakerID: o.BookmakerID},
+		Label:   o.Label,
+	}
+
+	if o.Total != nil {
+		k.Total = *o.Total
+	}
+
+	if o.Handicap != nil {
+		k.Handicap = *o.Handicap
+	}
+
+	return k
+}
@@ -0,0 +1,242 @@
+package sportmonks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func oddsBody(prices ...string) string {
+	labels := []string{"Home", "Draw", "Away"}
+	data := ""
+
+	for i, p := range prices {
+		if i > 0 {
+			data += ","
+		}
+
+		data += fmt.Sprintf(
+			`{"id": %d, "fixture_id": 19134492, "market_id": 1, "bookmaker_id": 2, "label": "%s", "value": "%s"}`,
+			i+1, labels[i], p,
+		)
+	}
+
+	return `{"data": [` + data + `]}`
+}
+
+// oddsTrackerServer serves the inplay odds response followed by each latest response in turn, repeating the final
+// response once all have been served.
+func oddsTrackerServer(inplay *http.Response, latest ...string) *http.Client {
+	var mu sync.Mutex
+	calls := 0
+
+	return newTestClient(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.URL.Path == "/v3/football/odds/inplay" {
+			return inplay
+		}
+
+		body := latest[len(latest)-1]
+
+		if calls < len(latest) {
+			body = latest[calls]
+		}
+
+		calls++
+
+		return stringResponse(200, body)
+	})
+}
+
+func receiveOddsMovements(t *testing.T, movements <-chan OddsMovement, n int) []OddsMovement {
+	var received []OddsMovement
+
+	for len(received) < n {
+		select {
+		case m := <-movements:
+			received = append(received, m)
+		case <-time.After(time.Second):
+			t.Fatalf("Test failed, expected %d movements, got %d", n, len(received))
+		}
+	}
+
+	return received
+}
+
+func TestOddsTracker(t *testing.T) {
+	t.Run("emits price movements between polls", func(t *testing.T) {
+		server := oddsTrackerServer(
+			stringResponse(200, oddsBody("2.10", "3.40", "3.60")),
+			oddsBody("2.10", "3.40", "3.60"),
+			oddsBody("1.80", "3.40", "4.50"),
+			oddsBody("1.50", "-", "4.50"),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		tracker := NewOddsTracker(newTestHTTPClient(server), time.Millisecond)
+
+		movements := tracker.Start(ctx)
+
+		received := receiveOddsMovements(t, movements, 3)
+
+		assert.Equal(t, "Home", received[0].Key.Label)
+		assert.Equal(t, 19134492, received[0].Key.FixtureID)
+		assert.Equal(t, 1, received[0].Key.MarketID)
+		assert.Equal(t, 2, received[0].Key.BookmakerID)
+		assert.Equal(t, 2.1, received[0].Opening)
+		assert.Equal(t, 2.1, received[0].Previous)
+		assert.Equal(t, 1.8, received[0].Current)
+		assert.InDelta(t, -0.3, received[0].Change(), 1e-9)
+		assert.Equal(t, "1.80", received[0].Odds.Value)
+
+		assert.Equal(t, "Away", received[1].Key.Label)
+		assert.Equal(t, 4.5, received[1].Current)
+
+		assert.Equal(t, "Home", received[2].Key.Label)
+		assert.Equal(t, 2.1, received[2].Opening)
+		assert.Equal(t, 1.8, received[2].Previous)
+		assert.Equal(t, 1.5, received[2].Current)
+
+		select {
+		case m := <-movements:
+			t.Fatalf("Test failed, expected no further movements, got %+v", m)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("ignores suspended and stopped prices", func(t *testing.T) {
+		odd := func(label, value, flag string) string {
+			return fmt.Sprintf(
+				`{"data": [{"id": 1, "fixture_id": 19134492, "market_id": 1, "bookmaker_id": 2, "label": "%s", "value": "%s"%s}]}`,
+				label, value, flag,
+			)
+		}
+
+		server := oddsTrackerServer(
+			stringResponse(200, odd("Home", "2.10", "")),
+			odd("Home", "1.80", `, "suspended": true`),
+			odd("Home", "1.70", `, "stopped": true`),
+			odd("Home", "1.90", ""),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		movements := NewOddsTracker(newTestHTTPClient(server), time.Millisecond).Start(ctx)
+
+		received := receiveOddsMovements(t, movements, 1)
+
+		assert.Equal(t, 2.1, received[0].Previous)
+		assert.Equal(t, 1.9, received[0].Current)
+
+		select {
+		case m := <-movements:
+			t.Fatalf("Test failed, expected no further movements, got %+v", m)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("forgets selections that are no longer seen", func(t *testing.T) {
+		server := oddsTrackerServer(
+			stringResponse(200, oddsBody("2.10", "3.40")),
+			oddsBody("2.10"),
+			oddsBody("2.10"),
+			oddsBody("1.80", "3.00"),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		tracker := NewOddsTracker(newTestHTTPClient(server), time.Millisecond)
+
+		var mu sync.Mutex
+		now := time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC)
+
+		tracker.now = func() time.Time {
+			mu.Lock()
+			defer mu.Unlock()
+
+			now = now.Add(31 * time.Minute)
+
+			return now
+		}
+
+		movements := tracker.Start(ctx)
+
+		received := receiveOddsMovements(t, movements, 1)
+
+		assert.Equal(t, "Home", received[0].Key.Label)
+
+		select {
+		case m := <-movements:
+			t.Fatalf("Test failed, expected no further movements, got %+v", m)
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+
+	t.Run("emits request errors and keeps polling", func(t *testing.T) {
+		server := oddsTrackerServer(stringResponse(400, errorResponse), oddsBody("2.10"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		tracker := NewOddsTracker(newTestHTTPClient(server), time.Millisecond)
+
+		received := receiveOddsMovements(t, tracker.Start(ctx), 2)
+
+		var bad *ErrBadStatusCode
+
+		assert.True(t, errors.As(received[0].Err, &bad))
+		assert.True(t, errors.As(received[1].Err, &bad))
+	})
+
+	t.Run("polls on a default interval when the interval is not positive", func(t *testing.T) {
+		server := oddsTrackerServer(stringResponse(200, oddsBody("2.10")), oddsBody("2.10"))
+
+		tracker := NewOddsTracker(newTestHTTPClient(server), -time.Second)
+
+		assert.Equal(t, defaultOddsTrackerInterval, tracker.Interval)
+
+		tracker.Interval = 0
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		movements := tracker.Start(ctx)
+
+		cancel()
+
+		select {
+		case _, ok := <-movements:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("Test failed, expected channel to be closed")
+		}
+	})
+
+	t.Run("closes the channel once the context is cancelled", func(t *testing.T) {
+		server := oddsTrackerServer(stringResponse(200, oddsBody("2.10")), oddsBody("2.10"))
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		movements := NewOddsTracker(newTestHTTPClient(server), time.Millisecond).Start(ctx)
+
+		cancel()
+
+		select {
+		case _, ok := <-movements:
+			assert.False(t, ok)
+		case <-time.After(time.Second):
+			t.Fatal("Test failed, expected channel to be closed")
+		}
+	})
+}
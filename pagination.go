@@ -0,0 +1,98 @@
+package sportmonks
+
+import (
+	"context"
+	"iter"
+	"net/url"
+	"strconv"
+)
+
+// PageFetcher fetches a single page of resources from a paginated endpoint.
+type PageFetcher[T any] func(ctx context.Context, page int) ([]T, *ResponseDetails, error)
+
+// Paginate returns an iterator that yields every resource returned by a paginated endpoint, starting at page 1 and
+// following the Pagination struct returned with each page until no more pages are available. The context is checked
+// before each page is requested and any error is yielded as the final value of the sequence.
+func Paginate[T any](ctx context.Context, fetch PageFetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		page := 1
+
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, details, err := fetch(ctx, page)
+
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next, ok := nextPage(details, page)
+
+			if !ok {
+				return
+			}
+
+			page = next
+		}
+	}
+}
+
+// Collect drains an iterator returned by Paginate into a slice. If max is greater than zero no more than max items
+// are collected and no further pages are requested once the limit is reached. Items collected before an error
+// occurred are returned alongside the error.
+func Collect[T any](seq iter.Seq2[T, error], max int) ([]T, error) {
+	var items []T
+
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, item)
+
+		if max > 0 && len(items) >= max {
+			break
+		}
+	}
+
+	return items, nil
+}
+
+func nextPage(details *ResponseDetails, current int) (int, bool) {
+	if details == nil || details.Pagination == nil || !details.Pagination.HasMore {
+		return 0, false
+	}
+
+	next := current + 1
+
+	if details.Pagination.CurrentPage > 0 {
+		next = details.Pagination.CurrentPage + 1
+	}
+
+	if details.Pagination.NextPage != nil {
+		if u, err := url.Parse(*details.Pagination.NextPage); err == nil {
+			if p, err := strconv.Atoi(u.Query().Get("page")); err == nil {
+				next = p
+			}
+		}
+	}
+
+	// Guard against an API response that would otherwise send the iterator round in circles.
+	if next <= current {
+		return 0, false
+	}
+
+	return next, true
+}
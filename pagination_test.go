@@ -0,0 +1,164 @@
+package sportmonks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func leaguesPageResponse(page int, hasMore bool) string {
+	next := "null"
+
+	if hasMore {
+		next = fmt.Sprintf(`"https://api.sportmonks.com/v3/football/leagues?page=%d"`, page+1)
+	}
+
+	return fmt.Sprintf(`{
+		"data": [
+			{"id": %d, "name": "League %d"},
+			{"id": %d, "name": "League %d"}
+		],
+		"pagination": {
+			"count": 2,
+			"per_page": 2,
+			"current_page": %d,
+			"next_page": %s,
+			"has_more": %t
+		}
+	}`, page*10+1, page*10+1, page*10+2, page*10+2, page, next, hasMore)
+}
+
+func pagedLeaguesServer(t *testing.T, pages int, requested *[]string) *http.Client {
+	return newTestClient(func(req *http.Request) *http.Response {
+		page := req.URL.Query().Get("page")
+
+		*requested = append(*requested, page)
+
+		var n int
+		_, err := fmt.Sscanf(page, "%d", &n)
+		assert.Nil(t, err)
+
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(leaguesPageResponse(n, n < pages))),
+		}
+	})
+}
+
+func TestPaginate(t *testing.T) {
+	t.Run("follows pagination until has more is false", func(t *testing.T) {
+		var requested []string
+
+		client := newTestHTTPClient(pagedLeaguesServer(t, 3, &requested))
+
+		var ids []int
+
+		for league, err := range client.LeaguesIter(context.Background(), nil) {
+			if err != nil {
+				t.Fatalf("Test failed, expected nil, got %s", err.Error())
+			}
+
+			ids = append(ids, league.ID)
+		}
+
+		assert.Equal(t, []int{11, 12, 21, 22, 31, 32}, ids)
+		assert.Equal(t, []string{"1", "2", "3"}, requested)
+	})
+
+	t.Run("stops requesting pages when the consumer stops iterating", func(t *testing.T) {
+		var requested []string
+
+		client := newTestHTTPClient(pagedLeaguesServer(t, 3, &requested))
+
+		for league := range client.LeaguesIter(context.Background(), nil) {
+			if league.ID == 12 {
+				break
+			}
+		}
+
+		assert.Equal(t, []string{"1"}, requested)
+	})
+
+	t.Run("yields error returned when fetching a page", func(t *testing.T) {
+		fetch := func(ctx context.Context, page int) ([]int, *ResponseDetails, error) {
+			if page == 2 {
+				return nil, nil, errors.New("page failed")
+			}
+
+			return []int{1, 2}, &ResponseDetails{Pagination: &Pagination{CurrentPage: page, HasMore: true}}, nil
+		}
+
+		items, err := Collect(Paginate(context.Background(), fetch), 0)
+
+		assert.Equal(t, []int{1, 2}, items)
+		assert.Equal(t, "page failed", err.Error())
+	})
+
+	t.Run("honours context cancellation between pages", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		calls := 0
+
+		fetch := func(ctx context.Context, page int) ([]int, *ResponseDetails, error) {
+			calls++
+			cancel()
+			return []int{page}, &ResponseDetails{Pagination: &Pagination{CurrentPage: page, HasMore: true}}, nil
+		}
+
+		items, err := Collect(Paginate(ctx, fetch), 0)
+
+		assert.Equal(t, []int{1}, items)
+		assert.Equal(t, context.Canceled, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("stops when pagination does not advance", func(t *testing.T) {
+		calls := 0
+
+		next := "https://api.sportmonks.com/v3/football/leagues?page=1"
+
+		fetch := func(ctx context.Context, page int) ([]int, *ResponseDetails, error) {
+			calls++
+			return []int{page}, &ResponseDetails{Pagination: &Pagination{CurrentPage: page, NextPage: &next, HasMore: true}}, nil
+		}
+
+		items, err := Collect(Paginate(context.Background(), fetch), 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []int{1}, items)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("collects all items across pages", func(t *testing.T) {
+		var requested []string
+
+		client := newTestHTTPClient(pagedLeaguesServer(t, 2, &requested))
+
+		leagues, err := Collect(client.LeaguesIter(context.Background(), nil), 0)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 4, len(leagues))
+		assert.Equal(t, "League 22", leagues[3].Name)
+	})
+
+	t.Run("caps the number of items collected", func(t *testing.T) {
+		var requested []string
+
+		client := newTestHTTPClient(pagedLeaguesServer(t, 3, &requested))
+
+		leagues, err := Collect(client.LeaguesIter(context.Background(), nil), 3)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, len(leagues))
+		assert.Equal(t, 21, leagues[2].ID)
+		assert.Equal(t, []string{"1", "2"}, requested)
+	})
+}
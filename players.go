@@ -3,8 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 )
 
 // Player provides a struct representation of a Player resource.
@@ -25,28 +23,15 @@ type Player struct {
 	ImagePath          string `json:"image_path"`
 	Height             int    `json:"height"`
 	Weight             int    `json:"weight"`
-	DateOfBirth        string `json:"date_of_birth"`
+	DateOfBirth        Date   `json:"date_of_birth"`
 	Gender             string `json:"gender"`
 }
 
-// PlayerByID fetches a Player resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) PlayerByID(ctx context.Context, id int, includes []string) (*Player, *Meta, error) {
+// PlayerByID fetches a Player resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) PlayerByID(ctx context.Context, id int, query *Query) (*Player, *ResponseDetails, error) {
 	path := fmt.Sprintf(playersURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	values := query.Values()
 
-	response := struct {
-		Data *Player `json:"data"`
-		Meta *Meta   `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getOne[Player](ctx, c, path, values)
 }
@@ -26,7 +26,31 @@ var playerResponse = `{
 		  "weight": 78,
 		  "date_of_birth": "1979-10-25",
 		  "gender": "male"
-    }
+    },
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Player"
+	},
+	"timezone": "UTC"
 }`
 
 var playerIncludesResponse = `{
@@ -54,13 +78,13 @@ var playerIncludesResponse = `{
 
 func TestPlayerByID(t *testing.T) {
 	t.Run("return a single Player struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/players/219591?api_token=api-key&include="
+		url := defaultBaseURL + "/football/players/219591?api_token=api-key"
 
 		server := mockResponseServer(t, playerResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		player, _, err := client.PlayerByID(context.Background(), 219591, []string{})
+		player, _, err := client.PlayerByID(context.Background(), 219591, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", err)
@@ -76,7 +100,7 @@ func TestPlayerByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		player, _, err := client.PlayerByID(context.Background(), 219591, []string{"stats", "position", "trophies"})
+		player, _, err := client.PlayerByID(context.Background(), 219591, NewQuery().Include("stats", "position", "trophies"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", err)
@@ -86,13 +110,13 @@ func TestPlayerByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/players/219591?api_token=api-key&include="
+		url := defaultBaseURL + "/football/players/219591?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		player, _, err := client.PlayerByID(context.Background(), 219591, []string{})
+		player, _, err := client.PlayerByID(context.Background(), 219591, nil)
 
 		if player != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", player)
@@ -100,6 +124,18 @@ func TestPlayerByID(t *testing.T) {
 
 		assertError(t, err)
 	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		url := defaultBaseURL + "/football/players/219591?api_token=api-key"
+
+		server := mockResponseServer(t, playerResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.PlayerByID(context.Background(), 219591, nil)
+
+		assertResponseDetails(t, details, "Player")
+	})
 }
 
 func assertPlayer(t *testing.T, player *Player) {
@@ -119,6 +155,6 @@ func assertPlayer(t *testing.T, player *Player) {
 	assert.Equal(t, "https://cdn.sportmonks.com/images/soccer/players/1/1.png", player.ImagePath)
 	assert.Equal(t, 187, player.Height)
 	assert.Equal(t, 78, player.Weight)
-	assert.Equal(t, "1979-10-25", player.DateOfBirth)
+	assert.Equal(t, "1979-10-25", player.DateOfBirth.String())
 	assert.Equal(t, "male", player.Gender)
 }
@@ -0,0 +1,184 @@
+package sportmonks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// Probability provides a struct representation of a Probability resource, holding the predicted probabilities of the
+// outcomes of one market of a fixture. The TypeID identifies the market predicted, e.g. the fulltime result.
+type Probability struct {
+	ID          int                    `json:"id"`
+	FixtureID   int                    `json:"fixture_id"`
+	TypeID      int                    `json:"type_id"`
+	Predictions ProbabilityPredictions `json:"predictions"`
+	Fixture     *Fixture               `json:"fixture,omitempty"`
+}
+
+// ProbabilityPredictions holds the predicted probability of each outcome as a percentage, keyed by outcome such as
+// 'home', 'draw' and 'away', or for correct score predictions keyed by score such as '1-0'.
+type ProbabilityPredictions struct {
+	Outcomes map[string]float64
+	Scores   map[string]float64
+}
+
+// UnmarshalJSON decodes the predictions object, holding the numeric outcomes within Outcomes and the scores object of
+// correct score predictions within Scores.
+func (p *ProbabilityPredictions) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	p.Outcomes = map[string]float64{}
+
+	for k, v := range raw {
+		if k == "scores" {
+			if err := json.Unmarshal(v, &p.Scores); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		var f float64
+
+		if err := json.Unmarshal(v, &f); err != nil {
+			return fmt.Errorf("prediction '%s': %w", k, err)
+		}
+
+		p.Outcomes[k] = f
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the predictions in the same form as the API.
+func (p ProbabilityPredictions) MarshalJSON() ([]byte, error) {
+	raw := make(map[string]any, len(p.Outcomes)+1)
+
+	for k, v := range p.Outcomes {
+		raw[k] = v
+	}
+
+	if p.Scores != nil {
+		raw["scores"] = p.Scores
+	}
+
+	return json.Marshal(raw)
+}
+
+// Outcome returns the predicted probability of the outcome as a fraction between 0 and 1. The boolean is false if the
+// outcome is not predicted.
+func (p *Probability) Outcome(outcome string) (float64, bool) {
+	v, ok := p.Predictions.Outcomes[outcome]
+
+	return v / 100, ok
+}
+
+// FairOdds returns the decimal odds equivalent to the predicted probability of the outcome. The boolean is false if
+// the outcome is not predicted or has a probability of zero.
+func (p *Probability) FairOdds(outcome string) (float64, bool) {
+	v, ok := p.Outcome(outcome)
+
+	if !ok || v <= 0 {
+		return 0, false
+	}
+
+	return 1 / v, true
+}
+
+// ValueBet provides a struct representation of a ValueBet resource, a bet on a fixture where the odds offered by a
+// bookmaker exceed the fair odds of the prediction model.
+type ValueBet struct {
+	ID          int                `json:"id"`
+	FixtureID   int                `json:"fixture_id"`
+	TypeID      int                `json:"type_id"`
+	Predictions ValueBetPrediction `json:"predictions"`
+	Fixture     *Fixture           `json:"fixture,omitempty"`
+}
+
+// ValueBetPrediction holds the detail of a value bet. Odds are decimal odds.
+type ValueBetPrediction struct {
+	Bet       string  `json:"bet"`
+	Bookmaker string  `json:"bookmaker"`
+	FairOdd   float64 `json:"fair_odd"`
+	Odd       float64 `json:"odd"`
+	Stake     float64 `json:"stake"`
+	IsValue   bool    `json:"is_value"`
+}
+
+// Predictability provides a struct representation of a Predictability resource, measuring how accurately the
+// outcomes of the fixtures of a league are predicted. Data holds the accuracy of each market, keyed by market.
+type Predictability struct {
+	ID       int                `json:"id"`
+	LeagueID int                `json:"league_id"`
+	TypeID   int                `json:"type_id"`
+	Data     map[string]float64 `json:"data"`
+}
+
+// Probabilities fetches Probability resources. The endpoint used within this method is paginated, to select the
+// required page use the 'page' method argument. Pagination information including current page and count are included
+// within the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Probabilities(ctx context.Context, page int, query *Query) ([]Probability, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[Probability](ctx, c, probabilitiesURI, values)
+}
+
+// ProbabilitiesIter returns an iterator over Probability resources that transparently requests each page of the
+// paginated endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ProbabilitiesIter(ctx context.Context, query *Query) iter.Seq2[Probability, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Probability, *ResponseDetails, error) {
+		return c.Probabilities(ctx, page, query)
+	})
+}
+
+// ProbabilitiesByFixtureID fetches the Probability resources of a fixture. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) ProbabilitiesByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]Probability, *ResponseDetails, error) {
+	path := fmt.Sprintf(probabilitiesFixtureURI+"/%d", fixtureID)
+
+	return getMany[Probability](ctx, c, path, query.Values())
+}
+
+// ValueBets fetches ValueBet resources. The endpoint used within this method is paginated, to select the required page
+// use the 'page' method argument. Pagination information including current page and count are included within the
+// Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ValueBets(ctx context.Context, page int, query *Query) ([]ValueBet, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[ValueBet](ctx, c, valueBetsURI, values)
+}
+
+// ValueBetsIter returns an iterator over ValueBet resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) ValueBetsIter(ctx context.Context, query *Query) iter.Seq2[ValueBet, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]ValueBet, *ResponseDetails, error) {
+		return c.ValueBets(ctx, page, query)
+	})
+}
+
+// ValueBetsByFixtureID fetches the ValueBet resources of a fixture. Use the query to enrich and filter the response
+// data.
+func (c *HTTPClient) ValueBetsByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]ValueBet, *ResponseDetails, error) {
+	path := fmt.Sprintf(valueBetsFixtureURI+"/%d", fixtureID)
+
+	return getMany[ValueBet](ctx, c, path, query.Values())
+}
+
+// PredictabilityByLeagueID fetches the Predictability resources of a league. Use the query to enrich and filter the
+// response data.
+func (c *HTTPClient) PredictabilityByLeagueID(ctx context.Context, leagueID int, query *Query) ([]Predictability, *ResponseDetails, error) {
+	path := fmt.Sprintf(predictabilityLeagueURI+"/%d", leagueID)
+
+	return getMany[Predictability](ctx, c, path, query.Values())
+}
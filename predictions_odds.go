@@ -0,0 +1,133 @@
+package sportmonks
+
+// PredictionMarket maps the outcomes of a Probability type to the odds of the matching market.
+type PredictionMarket struct {
+	// TypeID is the type of the Probability resources.
+	TypeID int
+	// MarketID is the market of the odds.
+	MarketID int
+	// Total and Handicap select the market line of the odds, empty for markets without lines.
+	Total    string
+	Handicap string
+	// Outcomes maps each prediction outcome, e.g. 'home', to the Label of the odds, e.g. 'Home'.
+	Outcomes map[string]string
+}
+
+// DefaultPredictionMarkets returns the mapping of the fulltime result and both teams to score predictions to their odds
+// markets.
+func DefaultPredictionMarkets() []PredictionMarket {
+	return []PredictionMarket{
+		{
+			TypeID:   int(PredictionFulltimeResultProbability),
+			MarketID: 1,
+			Outcomes: map[string]string{"home": "Home", "draw": "Draw", "away": "Away"},
+		},
+		{
+			TypeID:   int(PredictionBTTSProbability),
+			MarketID: 14,
+			Outcomes: map[string]string{"yes": "Yes", "no": "No"},
+		},
+	}
+}
+
+// PricedPrediction is a predicted outcome joined to the odds offered on it by a bookmaker.
+type PricedPrediction struct {
+	FixtureID int
+	TypeID    int
+	Outcome   string
+	// Probability is the predicted probability of the outcome as a fraction between 0 and 1.
+	Probability float64
+	// FairOdds is the decimal odds equivalent to the Probability.
+	FairOdds float64
+	// Odds are the odds offered on the outcome.
+	Odds PrematchOdds
+	// Price is the decimal price of the Odds.
+	Price float64
+	// Edge is the expected profit per unit staked at the Price if the Probability is accurate, positive when the
+	// price offers value.
+	Edge float64
+}
+
+// JoinPredictions joins the outcomes of each Probability to the odds of the same fixture on the matching market, as
+// mapped by the markets, e.g. DefaultPredictionMarkets. An outcome is joined to the odds of every bookmaker offering
+// it, in the order of the odds. Probabilities and odds without a mapping are ignored, as are outcomes predicted with a
+// probability of zero and odds that are stopped or without a valid decimal price.
+func JoinPredictions(probabilities []Probability, odds []PrematchOdds, markets []PredictionMarket) []PricedPrediction {
+	byType := make(map[int]PredictionMarket, len(markets))
+
+	for _, m := range markets {
+		byType[m.TypeID] = m
+	}
+
+	type outcome struct {
+		fixtureID int
+		marketID  int
+		total     string
+		handicap  string
+		label     string
+	}
+
+	predicted := map[outcome][]PricedPrediction{}
+
+	for i := range probabilities {
+		p := &probabilities[i]
+		m, ok := byType[p.TypeID]
+
+		if !ok {
+			continue
+		}
+
+		for name, label := range m.Outcomes {
+			probability, ok := p.Outcome(name)
+
+			if !ok || probability <= 0 {
+				continue
+			}
+
+			k := outcome{fixtureID: p.FixtureID, marketID: m.MarketID, total: m.Total, handicap: m.Handicap, label: label}
+
+			predicted[k] = append(predicted[k], PricedPrediction{
+				FixtureID:   p.FixtureID,
+				TypeID:      p.TypeID,
+				Outcome:     name,
+				Probability: probability,
+				FairOdds:    1 / probability,
+			})
+		}
+	}
+
+	var joined []PricedPrediction
+
+	for i := range odds {
+		book := BookKeyOf(&odds[i])
+		k := outcome{
+			fixtureID: book.FixtureID,
+			marketID:  book.MarketID,
+			total:     book.Total,
+			handicap:  book.Handicap,
+			label:     odds[i].Label,
+		}
+
+		predictions, ok := predicted[k]
+
+		if !ok || odds[i].Stopped {
+			continue
+		}
+
+		price, err := odds[i].DecimalOdds()
+
+		if err != nil {
+			continue
+		}
+
+		for _, p := range predictions {
+			p.Odds = odds[i]
+			p.Price = price
+			p.Edge = p.Probability*price - 1
+
+			joined = append(joined, p)
+		}
+	}
+
+	return joined
+}
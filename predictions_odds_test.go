@@ -0,0 +1,83 @@
+package sportmonks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJoinPredictions(t *testing.T) {
+	probabilities := []Probability{
+		{ID: 1, FixtureID: 1, TypeID: int(PredictionFulltimeResultProbability), Predictions: ProbabilityPredictions{Outcomes: map[string]float64{"home": 50, "draw": 25, "away": 25}}},
+		{ID: 2, FixtureID: 1, TypeID: int(PredictionBTTSProbability), Predictions: ProbabilityPredictions{Outcomes: map[string]float64{"yes": 60, "no": 0}}},
+		{ID: 3, FixtureID: 1, TypeID: 240, Predictions: ProbabilityPredictions{Scores: map[string]float64{"1-0": 10}}},
+	}
+
+	odds := []PrematchOdds{
+		{ID: 10, FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "2.20"},
+		{ID: 11, FixtureID: 1, MarketID: 1, BookmakerID: 2, Label: "Draw", Value: "3.60"},
+		{ID: 12, FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Home", Value: "1.90"},
+		{ID: 13, FixtureID: 1, MarketID: 14, BookmakerID: 2, Label: "Yes", Value: "1.80"},
+		{ID: 14, FixtureID: 1, MarketID: 14, BookmakerID: 2, Label: "No", Value: "2.00"},
+		{ID: 15, FixtureID: 2, MarketID: 1, BookmakerID: 2, Label: "Home", Value: "1.50"},
+		{ID: 16, FixtureID: 1, MarketID: 80, BookmakerID: 2, Label: "Over", Value: "-"},
+	}
+
+	t.Run("joins predicted outcomes to the odds of each bookmaker", func(t *testing.T) {
+		joined := JoinPredictions(probabilities, odds, DefaultPredictionMarkets())
+
+		assert.Equal(t, 4, len(joined))
+
+		home := joined[0]
+
+		assert.Equal(t, 1, home.FixtureID)
+		assert.Equal(t, int(PredictionFulltimeResultProbability), home.TypeID)
+		assert.Equal(t, "home", home.Outcome)
+		assert.Equal(t, 0.5, home.Probability)
+		assert.Equal(t, 2.0, home.FairOdds)
+		assert.Equal(t, 10, home.Odds.ID)
+		assert.Equal(t, 2.2, home.Price)
+		assert.InDelta(t, 0.1, home.Edge, 1e-9)
+
+		assert.Equal(t, 11, joined[1].Odds.ID)
+		assert.InDelta(t, -0.1, joined[1].Edge, 1e-9)
+
+		assert.Equal(t, 12, joined[2].Odds.ID)
+		assert.InDelta(t, -0.05, joined[2].Edge, 1e-9)
+
+		assert.Equal(t, "yes", joined[3].Outcome)
+		assert.Equal(t, 13, joined[3].Odds.ID)
+	})
+
+	t.Run("joins lines using the mapping", func(t *testing.T) {
+		markets := []PredictionMarket{{TypeID: 235, MarketID: 80, Total: "2.5", Outcomes: map[string]string{"yes": "Over"}}}
+
+		probabilities := []Probability{
+			{FixtureID: 1, TypeID: 235, Predictions: ProbabilityPredictions{Outcomes: map[string]float64{"yes": 55}}},
+		}
+
+		odds := []PrematchOdds{
+			{ID: 1, FixtureID: 1, MarketID: 80, Label: "Over", Value: "1.95", Total: stringPtr("2.5")},
+			{ID: 2, FixtureID: 1, MarketID: 80, Label: "Over", Value: "1.40", Total: stringPtr("1.5")},
+		}
+
+		joined := JoinPredictions(probabilities, odds, markets)
+
+		assert.Equal(t, 1, len(joined))
+		assert.Equal(t, 1, joined[0].Odds.ID)
+	})
+
+	t.Run("ignores malformed and stopped prices", func(t *testing.T) {
+		odds := append(odds[:3:3],
+			PrematchOdds{ID: 20, FixtureID: 1, MarketID: 1, BookmakerID: 9, Label: "Draw", Value: "-"},
+			PrematchOdds{ID: 21, FixtureID: 1, MarketID: 1, BookmakerID: 23, Label: "Home", Value: "2.40", Stopped: true},
+		)
+
+		joined := JoinPredictions(probabilities, odds, DefaultPredictionMarkets())
+
+		assert.Equal(t, 3, len(joined))
+		assert.Equal(t, 10, joined[0].Odds.ID)
+		assert.Equal(t, 11, joined[1].Odds.ID)
+		assert.Equal(t, 12, joined[2].Odds.ID)
+	})
+}
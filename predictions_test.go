@@ -0,0 +1,303 @@
+package sportmonks
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var probabilitiesResponse = `{
+	"data": [
+		{
+			"id": 1001,
+			"fixture_id": 19134492,
+			"predictions": {
+				"home": 45.5,
+				"away": 27.5,
+				"draw": 27
+			},
+			"type_id": 237
+		},
+		{
+			"id": 1002,
+			"fixture_id": 19134492,
+			"predictions": {
+				"scores": {
+					"0-0": 8.5,
+					"1-0": 11.25,
+					"Other_1": 2.1
+				}
+			},
+			"type_id": 240
+		}
+	],
+	"pagination": {
+		"count": 2,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Prediction"
+	},
+	"timezone": "UTC"
+}`
+
+var valueBetsResponse = `{
+	"data": [
+		{
+			"id": 2001,
+			"fixture_id": 19134492,
+			"predictions": {
+				"bet": "X",
+				"bookmaker": "bet365",
+				"fair_odd": 3.39,
+				"odd": 3.6,
+				"stake": 1.37,
+				"is_value": true
+			},
+			"type_id": 33
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "ValueBet"
+	},
+	"timezone": "UTC"
+}`
+
+var predictabilityResponse = `{
+	"data": [
+		{
+			"id": 3001,
+			"league_id": 8,
+			"type_id": 245,
+			"data": {
+				"all": 0.59,
+				"fulltime_result": 0.61,
+				"both_teams_to_score": 0.57
+			}
+		}
+	],
+	"timezone": "UTC"
+}`
+
+func TestProbabilities(t *testing.T) {
+	url := defaultBaseURL + "/football/predictions/probabilities?api_token=api-key&page=1"
+
+	t.Run("returns Probability struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, probabilitiesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		probabilities, details, err := client.Probabilities(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertProbabilities(t, probabilities)
+		assert.Equal(t, 2, details.Pagination.Count)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		probabilities, _, err := client.Probabilities(context.Background(), 1, nil)
+
+		if probabilities != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", probabilities)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestProbabilitiesByFixtureID(t *testing.T) {
+	t.Run("returns Probability struct slice", func(t *testing.T) {
+		url := defaultBaseURL + "/football/predictions/probabilities/fixtures/19134492?api_token=api-key"
+
+		server := mockResponseServer(t, probabilitiesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		probabilities, _, err := client.ProbabilitiesByFixtureID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertProbabilities(t, probabilities)
+	})
+}
+
+func TestValueBets(t *testing.T) {
+	url := defaultBaseURL + "/football/predictions/value-bets?api_token=api-key&page=1"
+
+	t.Run("returns ValueBet struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, valueBetsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bets, _, err := client.ValueBets(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertValueBet(t, &bets[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		bets, _, err := client.ValueBets(context.Background(), 1, nil)
+
+		if bets != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", bets)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestValueBetsByFixtureID(t *testing.T) {
+	t.Run("returns ValueBet struct slice", func(t *testing.T) {
+		url := defaultBaseURL + "/football/predictions/value-bets/fixtures/19134492?api_token=api-key"
+
+		server := mockResponseServer(t, valueBetsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		bets, _, err := client.ValueBetsByFixtureID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertValueBet(t, &bets[0])
+	})
+}
+
+func TestPredictabilityByLeagueID(t *testing.T) {
+	url := defaultBaseURL + "/football/predictions/predictability/leagues/8?api_token=api-key"
+
+	t.Run("returns Predictability struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, predictabilityResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		predictability, _, err := client.PredictabilityByLeagueID(context.Background(), 8, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3001, predictability[0].ID)
+		assert.Equal(t, 8, predictability[0].LeagueID)
+		assert.Equal(t, 245, predictability[0].TypeID)
+		assert.Equal(t, 0.61, predictability[0].Data["fulltime_result"])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		predictability, _, err := client.PredictabilityByLeagueID(context.Background(), 8, nil)
+
+		if predictability != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", predictability)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestProbabilityPredictions(t *testing.T) {
+	t.Run("marshals in the same form as the API", func(t *testing.T) {
+		var p ProbabilityPredictions
+
+		input := `{"draw":27,"home":45.5,"scores":{"1-0":11.25}}`
+
+		if err := json.Unmarshal([]byte(input), &p); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		b, err := json.Marshal(p)
+
+		assert.Nil(t, err)
+		assert.Equal(t, input, string(b))
+	})
+
+	t.Run("returns an error for an unexpected value", func(t *testing.T) {
+		var p ProbabilityPredictions
+
+		err := json.Unmarshal([]byte(`{"home":"45.5%"}`), &p)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("returns the probability and fair odds of an outcome", func(t *testing.T) {
+		p := Probability{Predictions: ProbabilityPredictions{Outcomes: map[string]float64{"home": 40, "draw": 0}}}
+
+		probability, ok := p.Outcome("home")
+
+		assert.True(t, ok)
+		assert.Equal(t, 0.4, probability)
+
+		odds, ok := p.FairOdds("home")
+
+		assert.True(t, ok)
+		assert.Equal(t, 2.5, odds)
+
+		_, ok = p.FairOdds("draw")
+
+		assert.False(t, ok)
+
+		_, ok = p.Outcome("away")
+
+		assert.False(t, ok)
+	})
+}
+
+func assertProbabilities(t *testing.T, probabilities []Probability) {
+	assert.Equal(t, 2, len(probabilities))
+
+	result := probabilities[0]
+
+	assert.Equal(t, 1001, result.ID)
+	assert.Equal(t, 19134492, result.FixtureID)
+	assert.Equal(t, 237, result.TypeID)
+	assert.Equal(t, map[string]float64{"home": 45.5, "away": 27.5, "draw": 27}, result.Predictions.Outcomes)
+	assert.Nil(t, result.Predictions.Scores)
+
+	scores := probabilities[1]
+
+	assert.Equal(t, 240, scores.TypeID)
+	assert.Empty(t, scores.Predictions.Outcomes)
+	assert.Equal(t, 11.25, scores.Predictions.Scores["1-0"])
+	assert.Equal(t, 3, len(scores.Predictions.Scores))
+}
+
+func assertValueBet(t *testing.T, bet *ValueBet) {
+	assert.Equal(t, 2001, bet.ID)
+	assert.Equal(t, 19134492, bet.FixtureID)
+	assert.Equal(t, 33, bet.TypeID)
+	assert.Equal(t, "X", bet.Predictions.Bet)
+	assert.Equal(t, "bet365", bet.Predictions.Bookmaker)
+	assert.Equal(t, 3.39, bet.Predictions.FairOdd)
+	assert.Equal(t, 3.6, bet.Predictions.Odd)
+	assert.Equal(t, 1.37, bet.Predictions.Stake)
+	assert.True(t, bet.Predictions.IsValue)
+}
@@ -0,0 +1,222 @@
+package sportmonks
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// SortOrder is the direction in which sorted data is returned.
+type SortOrder string
+
+const (
+	Asc  SortOrder = "asc"
+	Desc SortOrder = "desc"
+)
+
+// Query builds the include, select, filter, sort, pagination and localisation parameters accepted by the API. A nil
+// Query is valid and adds no parameters to a request. Clauses are encoded in the order they were added so the same
+// Query always produces the same request.
+type Query struct {
+	includes []*include
+	selects  []string
+	filters  []*filter
+	sortBy   string
+	order    SortOrder
+	perPage  int
+	locale   string
+	timezone string
+}
+
+type include struct {
+	name      string
+	modifiers []string
+}
+
+type filter struct {
+	name   string
+	values []string
+}
+
+// NewQuery creates a new empty Query.
+func NewQuery() *Query {
+	return &Query{}
+}
+
+// Include adds relations to enrich the response data, e.g. 'participants' or 'lineups.player'.
+func (q *Query) Include(includes ...string) *Query {
+	for _, i := range includes {
+		q.include(i)
+	}
+
+	return q
+}
+
+// NestedInclude adds a nested relation built from the given path, e.g. NestedInclude("lineups", "player") includes
+// 'lineups.player'.
+func (q *Query) NestedInclude(path ...string) *Query {
+	q.include(strings.Join(path, "."))
+	return q
+}
+
+// IncludeSelect adds a relation and limits the fields returned for it, e.g. IncludeSelect("events", "minute") includes
+// 'events:minute'.
+func (q *Query) IncludeSelect(relation string, fields ...string) *Query {
+	i := q.include(relation)
+	i.modifiers = append(i.modifiers, strings.Join(fields, ","))
+	return q
+}
+
+// IncludeFilter adds a relation and filters the data returned for it by field value, e.g.
+// IncludeFilter("odds", "bookmaker_id", "2") includes 'odds:filter(bookmaker_id|2)'.
+func (q *Query) IncludeFilter(relation, field string, values ...string) *Query {
+	i := q.include(relation)
+	i.modifiers = append(i.modifiers, "filter("+field+"|"+strings.Join(values, ",")+")")
+	return q
+}
+
+// IncludeSort adds a relation and sorts the data returned for it, e.g. IncludeSort("fixtures", "starting_at", Asc)
+// includes 'fixtures:order(starting_at|asc)'.
+func (q *Query) IncludeSort(relation, field string, order SortOrder) *Query {
+	i := q.include(relation)
+	i.modifiers = append(i.modifiers, "order("+field+"|"+string(order)+")")
+	return q
+}
+
+// IncludeLimit adds a relation and limits the number of records returned for it, e.g. IncludeLimit("fixtures", 5, 1)
+// includes 'fixtures:limit(5|1)'.
+func (q *Query) IncludeLimit(relation string, limit, page int) *Query {
+	i := q.include(relation)
+	i.modifiers = append(i.modifiers, "limit("+strconv.Itoa(limit)+"|"+strconv.Itoa(page)+")")
+	return q
+}
+
+// Select limits the fields returned for the base entity of the response.
+func (q *Query) Select(fields ...string) *Query {
+	q.selects = append(q.selects, fields...)
+	return q
+}
+
+// Filter adds a filter with integer values, e.g. Filter("fixtureLeagues", 8, 10) encodes as 'fixtureLeagues:8,10'.
+// Filtering on the same name more than once appends to the existing values.
+func (q *Query) Filter(name string, values ...int) *Query {
+	s := make([]string, len(values))
+
+	for i, v := range values {
+		s[i] = strconv.Itoa(v)
+	}
+
+	return q.FilterString(name, s...)
+}
+
+// FilterString adds a filter with string values, e.g. FilterString("populate") or FilterString("idAfter", "1000").
+// Filtering on the same name more than once appends to the existing values.
+func (q *Query) FilterString(name string, values ...string) *Query {
+	for _, f := range q.filters {
+		if f.name == name {
+			f.values = append(f.values, values...)
+			return q
+		}
+	}
+
+	q.filters = append(q.filters, &filter{name: name, values: values})
+
+	return q
+}
+
+// Sort orders the response data by the given field.
+func (q *Query) Sort(field string, order SortOrder) *Query {
+	q.sortBy = field
+	q.order = order
+	return q
+}
+
+// PerPage sets the number of records returned per page by paginated endpoints.
+func (q *Query) PerPage(n int) *Query {
+	q.perPage = n
+	return q
+}
+
+// Locale sets the language used for translatable fields in the response data.
+func (q *Query) Locale(locale string) *Query {
+	q.locale = locale
+	return q
+}
+
+// Timezone sets the timezone used for date and time fields in the response data, e.g. 'Europe/London'.
+func (q *Query) Timezone(tz string) *Query {
+	q.timezone = tz
+	return q
+}
+
+func (q *Query) include(name string) *include {
+	for _, i := range q.includes {
+		if i.name == name {
+			return i
+		}
+	}
+
+	i := &include{name: name}
+	q.includes = append(q.includes, i)
+
+	return i
+}
+
+// Values encodes the Query as request query parameters.
+func (q *Query) Values() url.Values {
+	values := url.Values{}
+
+	if q == nil {
+		return values
+	}
+
+	if len(q.includes) > 0 {
+		includes := make([]string, len(q.includes))
+
+		for n, i := range q.includes {
+			includes[n] = strings.Join(append([]string{i.name}, i.modifiers...), ":")
+		}
+
+		values.Set("include", strings.Join(includes, ";"))
+	}
+
+	if len(q.selects) > 0 {
+		values.Set("select", strings.Join(q.selects, ","))
+	}
+
+	if len(q.filters) > 0 {
+		filters := make([]string, len(q.filters))
+
+		for n, f := range q.filters {
+			filters[n] = f.name
+
+			if len(f.values) > 0 {
+				filters[n] += ":" + strings.Join(f.values, ",")
+			}
+		}
+
+		values.Set("filters", strings.Join(filters, ";"))
+	}
+
+	if q.sortBy != "" {
+		values.Set("sortBy", q.sortBy)
+
+		if q.order != "" {
+			values.Set("order", string(q.order))
+		}
+	}
+
+	if q.perPage > 0 {
+		values.Set("per_page", strconv.Itoa(q.perPage))
+	}
+
+	if q.locale != "" {
+		values.Set("locale", q.locale)
+	}
+
+	if q.timezone != "" {
+		values.Set("timezone", q.timezone)
+	}
+
+	return values
+}
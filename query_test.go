@@ -0,0 +1,119 @@
+package sportmonks
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuery(t *testing.T) {
+	t.Run("nil query adds no parameters", func(t *testing.T) {
+		var q *Query
+
+		assert.Equal(t, url.Values{}, q.Values())
+	})
+
+	t.Run("empty query adds no parameters", func(t *testing.T) {
+		assert.Equal(t, url.Values{}, NewQuery().Values())
+	})
+
+	t.Run("encodes includes separated by semicolons", func(t *testing.T) {
+		q := NewQuery().Include("participants", "scores").Include("league")
+
+		assert.Equal(t, "include=participants%3Bscores%3Bleague", q.Values().Encode())
+	})
+
+	t.Run("encodes nested includes", func(t *testing.T) {
+		q := NewQuery().NestedInclude("lineups", "player", "country")
+
+		assert.Equal(t, "lineups.player.country", q.Values().Get("include"))
+	})
+
+	t.Run("does not repeat an include added more than once", func(t *testing.T) {
+		q := NewQuery().Include("events", "events")
+
+		assert.Equal(t, "events", q.Values().Get("include"))
+	})
+
+	t.Run("encodes include field selection", func(t *testing.T) {
+		q := NewQuery().Include("participants").IncludeSelect("events", "player_name", "minute")
+
+		assert.Equal(t, "participants;events:player_name,minute", q.Values().Get("include"))
+	})
+
+	t.Run("encodes include filters", func(t *testing.T) {
+		q := NewQuery().IncludeFilter("odds", "bookmaker_id", "2", "34")
+
+		assert.Equal(t, "odds:filter(bookmaker_id|2,34)", q.Values().Get("include"))
+	})
+
+	t.Run("encodes include sort and limit", func(t *testing.T) {
+		q := NewQuery().IncludeSort("fixtures", "starting_at", Asc).IncludeLimit("fixtures", 5, 1)
+
+		assert.Equal(t, "fixtures:order(starting_at|asc):limit(5|1)", q.Values().Get("include"))
+	})
+
+	t.Run("encodes select fields", func(t *testing.T) {
+		q := NewQuery().Select("name", "starting_at")
+
+		assert.Equal(t, "name,starting_at", q.Values().Get("select"))
+	})
+
+	t.Run("encodes integer filters", func(t *testing.T) {
+		q := NewQuery().Filter("fixtureLeagues", 8, 10)
+
+		assert.Equal(t, "fixtureLeagues:8,10", q.Values().Get("filters"))
+	})
+
+	t.Run("encodes string filters and filters without values", func(t *testing.T) {
+		q := NewQuery().FilterString("populate").FilterString("eventTypes", "14", "15")
+
+		assert.Equal(t, "populate;eventTypes:14,15", q.Values().Get("filters"))
+	})
+
+	t.Run("encodes filters in the order they were added", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			q := NewQuery().Filter("team_id", 1, 2, 3).Filter("player_id", 4, 5, 6).Filter("team_id", 7)
+
+			assert.Equal(t, "team_id:1,2,3,7;player_id:4,5,6", q.Values().Get("filters"))
+		}
+	})
+
+	t.Run("escapes encoded values", func(t *testing.T) {
+		q := NewQuery().FilterString("name", "Man Utd & Co")
+
+		assert.Equal(t, "filters=name%3AMan+Utd+%26+Co", q.Values().Encode())
+	})
+
+	t.Run("encodes sort field and order", func(t *testing.T) {
+		q := NewQuery().Sort("starting_at", Desc)
+
+		assert.Equal(t, "order=desc&sortBy=starting_at", q.Values().Encode())
+	})
+
+	t.Run("encodes per page", func(t *testing.T) {
+		q := NewQuery().PerPage(50)
+
+		assert.Equal(t, "per_page=50", q.Values().Encode())
+	})
+
+	t.Run("encodes locale and timezone", func(t *testing.T) {
+		q := NewQuery().Locale("es").Timezone("Europe/London")
+
+		assert.Equal(t, "locale=es&timezone=Europe%2FLondon", q.Values().Encode())
+	})
+
+	t.Run("encodes all clauses together", func(t *testing.T) {
+		q := NewQuery().
+			Include("participants").
+			Select("name").
+			Filter("fixtureLeagues", 8).
+			Sort("starting_at", Asc).
+			PerPage(25)
+
+		expected := "filters=fixtureLeagues%3A8&include=participants&order=asc&per_page=25&select=name&sortBy=starting_at"
+
+		assert.Equal(t, expected, q.Values().Encode())
+	})
+}
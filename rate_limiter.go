@@ -0,0 +1,192 @@
+package sportmonks
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Quota provides a snapshot of the remaining API quota for a requested entity.
+type Quota struct {
+	Entity    string
+	Remaining int
+	ResetsAt  time.Time
+}
+
+// RateLimiter tracks the remaining quota reported in the rate_limit block of each response, per requested entity,
+// and holds back requests for an entity once its quota is close to exhausted. The zero value is ready to use.
+type RateLimiter struct {
+	// Reserve is the number of requests per entity held back from callers. Once the remaining quota for an entity
+	// falls to Reserve, requests for that entity wait for the reset window to pass if it resets within MaxWait, and
+	// otherwise fail with an ErrQuotaExhausted error.
+	Reserve int
+	// ThrottleBelow spreads requests for an entity evenly over the rest of the reset window once its remaining quota
+	// falls below this value. Zero disables throttling.
+	ThrottleBelow int
+	// MaxWait is the longest a request is blocked waiting for an exhausted quota to reset. If the reset is further
+	// away an ErrQuotaExhausted error is returned instead. Zero means requests are never blocked.
+	MaxWait time.Duration
+
+	mu       sync.Mutex
+	quotas   map[string]*quota
+	entities map[string]string
+	now      func() time.Time
+	sleep    func(ctx context.Context, d time.Duration) error
+}
+
+type quota struct {
+	Quota
+	nextAt time.Time
+}
+
+// NewRateLimiter creates a new RateLimiter holding back the given number of requests per entity.
+func NewRateLimiter(reserve int) *RateLimiter {
+	return &RateLimiter{
+		Reserve:  reserve,
+		quotas:   map[string]*quota{},
+		entities: map[string]string{},
+	}
+}
+
+// Wait blocks until a request to the given resource path is permitted by the known quota for its entity, or returns
+// an error if the context is done or the quota will not reset within MaxWait.
+func (r *RateLimiter) Wait(ctx context.Context, path string) error {
+	delay, err := r.reserve(path)
+
+	if err != nil || delay <= 0 {
+		return err
+	}
+
+	return r.wait(ctx, delay)
+}
+
+func (r *RateLimiter) reserve(path string) (time.Duration, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entity, ok := r.entities[resourceKey(path)]
+
+	if !ok {
+		return 0, nil
+	}
+
+	q := r.quotas[entity]
+	now := r.clock()
+
+	if !now.Before(q.ResetsAt) {
+		return 0, nil
+	}
+
+	if q.Remaining <= r.Reserve {
+		delay := q.ResetsAt.Sub(now)
+
+		if delay > r.MaxWait {
+			return 0, &ErrQuotaExhausted{Entity: entity, ResetsAt: q.ResetsAt}
+		}
+
+		return delay, nil
+	}
+
+	var delay time.Duration
+
+	if r.ThrottleBelow > 0 && q.Remaining < r.ThrottleBelow {
+		interval := q.ResetsAt.Sub(now) / time.Duration(q.Remaining-r.Reserve)
+		slot := now
+
+		if q.nextAt.After(slot) {
+			slot = q.nextAt
+		}
+
+		q.nextAt = slot.Add(interval)
+		delay = slot.Sub(now)
+	}
+
+	q.Remaining--
+
+	return delay, nil
+}
+
+// Observe records the rate limit information returned by the API for a request to the given resource path.
+func (r *RateLimiter) Observe(path string, limit RateLimit) {
+	if limit.RequestedEntity == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.quotas == nil {
+		r.quotas = map[string]*quota{}
+		r.entities = map[string]string{}
+	}
+
+	r.entities[resourceKey(path)] = limit.RequestedEntity
+
+	q, ok := r.quotas[limit.RequestedEntity]
+
+	if !ok {
+		q = &quota{}
+		r.quotas[limit.RequestedEntity] = q
+	}
+
+	q.Entity = limit.RequestedEntity
+	q.Remaining = limit.Remaining
+	q.ResetsAt = r.clock().Add(time.Duration(limit.ResetsInSeconds) * time.Second)
+}
+
+// Snapshot returns the most recently known quota for each entity, keyed by entity name. Entities whose reset window
+// has passed are omitted.
+func (r *RateLimiter) Snapshot() map[string]Quota {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.clock()
+	snapshot := make(map[string]Quota, len(r.quotas))
+
+	for entity, q := range r.quotas {
+		if now.Before(q.ResetsAt) {
+			snapshot[entity] = q.Quota
+		}
+	}
+
+	return snapshot
+}
+
+func (r *RateLimiter) clock() time.Time {
+	if r.now != nil {
+		return r.now()
+	}
+
+	return time.Now()
+}
+
+func (r *RateLimiter) wait(ctx context.Context, d time.Duration) error {
+	if r.sleep != nil {
+		return r.sleep(ctx, d)
+	}
+
+	return sleepContext(ctx, d)
+}
+
+// groupedResources are the resources whose sub resources request different entities, e.g. pre-match and in play
+// odds, and so are keyed on an extra segment.
+var groupedResources = map[string]bool{
+	"/football/odds":        true,
+	"/football/predictions": true,
+	"/football/standings":   true,
+}
+
+// resourceKey reduces a resource path to the segments identifying the entity it requests, for example
+// '/football/fixtures/between/2024-01-01/2024-01-31' becomes '/football/fixtures' and
+// '/football/odds/inplay/fixtures/1' becomes '/football/odds/inplay'.
+func resourceKey(path string) string {
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 4)
+	n := min(len(parts), 2)
+
+	if len(parts) > 2 && groupedResources["/"+strings.Join(parts[:2], "/")] {
+		n = 3
+	}
+
+	return "/" + strings.Join(parts[:n], "/")
+}
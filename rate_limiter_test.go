@@ -0,0 +1,211 @@
+package sportmonks
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var coachRateLimitedResponse = `{
+	"data": {
+		"id": 24,
+		"player_id": 24,
+		"country_id": 462,
+		"common_name": "D. Unsworth"
+	},
+	"rate_limit": {
+		"resets_in_seconds": 60,
+		"remaining": 1,
+		"requested_entity": "Coach"
+	}
+}`
+
+func newTestRateLimiter(now *time.Time, waits *[]time.Duration) *RateLimiter {
+	limiter := NewRateLimiter(0)
+
+	limiter.now = func() time.Time {
+		return *now
+	}
+
+	limiter.sleep = func(ctx context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		*now = now.Add(d)
+		return nil
+	}
+
+	return limiter
+}
+
+func TestRateLimiter(t *testing.T) {
+	start := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("tracks quota per requested entity from responses", func(t *testing.T) {
+		calls := 0
+		now := start
+		var waits []time.Duration
+
+		server := sequenceServer(&calls, stringResponse(200, coachRateLimitedResponse))
+
+		client := newTestHTTPClient(server)
+		client.RateLimiter = newTestRateLimiter(&now, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 24, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		expected := map[string]Quota{
+			"Coach": {
+				Entity:    "Coach",
+				Remaining: 1,
+				ResetsAt:  start.Add(time.Minute),
+			},
+		}
+
+		assert.Equal(t, expected, client.RateLimits())
+	})
+
+	t.Run("returns quota exhausted error when reset is beyond max wait", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.Observe("/football/coaches/24", RateLimit{ResetsInSeconds: 60, Remaining: 0, RequestedEntity: "Coach"})
+
+		err := limiter.Wait(context.Background(), "/football/coaches/50")
+
+		var quotaErr *ErrQuotaExhausted
+
+		assert.True(t, errors.As(err, &quotaErr))
+		assert.Equal(t, "Coach", quotaErr.Entity)
+		assert.Equal(t, start.Add(time.Minute), quotaErr.ResetsAt)
+		assert.Equal(t, 0, len(waits))
+	})
+
+	t.Run("blocks until reset when within max wait", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.MaxWait = 5 * time.Minute
+		limiter.Observe("/football/coaches/24", RateLimit{ResetsInSeconds: 60, Remaining: 0, RequestedEntity: "Coach"})
+
+		err := limiter.Wait(context.Background(), "/football/coaches/50")
+
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Duration{time.Minute}, waits)
+	})
+
+	t.Run("holds back reserved requests", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.Reserve = 2
+		limiter.Observe("/football/fixtures/1", RateLimit{ResetsInSeconds: 60, Remaining: 3, RequestedEntity: "Fixture"})
+
+		assert.Nil(t, limiter.Wait(context.Background(), "/football/fixtures/between/2024-01-01/2024-01-31"))
+
+		err := limiter.Wait(context.Background(), "/football/fixtures/2")
+
+		var quotaErr *ErrQuotaExhausted
+
+		assert.True(t, errors.As(err, &quotaErr))
+		assert.Equal(t, "Fixture", quotaErr.Entity)
+	})
+
+	t.Run("does not limit entities with unknown quota", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.Observe("/football/fixtures/1", RateLimit{ResetsInSeconds: 60, Remaining: 0, RequestedEntity: "Fixture"})
+
+		assert.Nil(t, limiter.Wait(context.Background(), "/football/teams/1"))
+	})
+
+	t.Run("tracks pre-match and in play odds separately", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.Observe("/football/odds/inplay/fixtures/1", RateLimit{ResetsInSeconds: 60, Remaining: 0, RequestedEntity: "InplayOdd"})
+
+		assert.Nil(t, limiter.Wait(context.Background(), "/football/odds/pre-match/fixtures/1"))
+
+		var quotaErr *ErrQuotaExhausted
+
+		assert.True(t, errors.As(limiter.Wait(context.Background(), "/football/odds/inplay/latest"), &quotaErr))
+	})
+
+	t.Run("zero value limiter is ready to use", func(t *testing.T) {
+		limiter := &RateLimiter{Reserve: 1}
+
+		assert.Nil(t, limiter.Wait(context.Background(), "/football/fixtures/1"))
+
+		limiter.Observe("/football/fixtures/1", RateLimit{ResetsInSeconds: 60, Remaining: 1, RequestedEntity: "Fixture"})
+
+		var quotaErr *ErrQuotaExhausted
+
+		assert.True(t, errors.As(limiter.Wait(context.Background(), "/football/fixtures/1"), &quotaErr))
+	})
+
+	t.Run("allows requests once the reset window has passed", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.Observe("/football/fixtures/1", RateLimit{ResetsInSeconds: 60, Remaining: 0, RequestedEntity: "Fixture"})
+
+		now = now.Add(61 * time.Second)
+
+		assert.Nil(t, limiter.Wait(context.Background(), "/football/fixtures/1"))
+		assert.Equal(t, map[string]Quota{}, limiter.Snapshot())
+	})
+
+	t.Run("spreads requests over the reset window when throttling", func(t *testing.T) {
+		now := start
+		var waits []time.Duration
+
+		limiter := newTestRateLimiter(&now, &waits)
+		limiter.ThrottleBelow = 10
+		limiter.Observe("/football/odds/pre-match", RateLimit{ResetsInSeconds: 60, Remaining: 4, RequestedEntity: "Odd"})
+
+		for i := 0; i < 3; i++ {
+			assert.Nil(t, limiter.Wait(context.Background(), "/football/odds/pre-match/fixtures/1"))
+		}
+
+		assert.Equal(t, []time.Duration{15 * time.Second, 20 * time.Second}, waits)
+		assert.Equal(t, 1, limiter.Snapshot()["Odd"].Remaining)
+	})
+
+	t.Run("treats a 429 response as exhausting the entity quota", func(t *testing.T) {
+		calls := 0
+		now := start
+		var waits []time.Duration
+
+		server := sequenceServer(&calls, stringResponse(http.StatusTooManyRequests, rateLimitResetErrorResponse))
+
+		client := newTestHTTPClient(server)
+		client.RateLimiter = newTestRateLimiter(&now, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		var rateLimitErr *ErrRateLimit
+
+		assert.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, 0, client.RateLimits()["Coach"].Remaining)
+
+		_, _, err = client.CoachByID(context.Background(), 2, nil)
+
+		var quotaErr *ErrQuotaExhausted
+
+		assert.True(t, errors.As(err, &quotaErr))
+		assert.Equal(t, 1, calls)
+	})
+}
@@ -1,8 +1,94 @@
 package sportmonks
 
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// ResponseDetails provides the pagination, subscription, rate limit and timezone information returned alongside the
+// data of every API response.
 type ResponseDetails struct {
 	Pagination   *Pagination    `json:"pagination"`
 	Subscription []Subscription `json:"subscription"`
 	RateLimit    RateLimit      `json:"rate_limit"`
 	TimeZone     string         `json:"timezone"`
+	// Raw holds the full response body when the request was made with a context returned by WithRawResponse.
+	Raw json.RawMessage `json:"-"`
+}
+
+// Envelope is the response body shared by every API endpoint, holding the requested data of type T alongside the
+// ResponseDetails.
+type Envelope[T any] struct {
+	Data T `json:"data"`
+	ResponseDetails
+}
+
+// DecodeEnvelope decodes a raw API response body, such as ResponseDetails.Raw, into an Envelope holding data of type T.
+func DecodeEnvelope[T any](body []byte) (*Envelope[T], error) {
+	var e Envelope[T]
+
+	if err := json.Unmarshal(body, &e); err != nil {
+		return nil, err
+	}
+
+	localize(&e.Data, e.TimeZone)
+
+	return &e, nil
+}
+
+type rawResponseKey struct{}
+
+// WithRawResponse returns a context that causes requests made with it to keep the full response body within
+// ResponseDetails.Raw, giving access to fields not yet mapped to a struct.
+func WithRawResponse(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rawResponseKey{}, true)
+}
+
+// getEnvelope fetches the resource at path and decodes the response Envelope. Every endpoint decodes responses through
+// this function, via getOne or getMany, so the ResponseDetails are populated consistently.
+func getEnvelope[T any](ctx context.Context, c *HTTPClient, path string, values url.Values) (*Envelope[T], error) {
+	var e Envelope[T]
+
+	body, err := c.getResource(ctx, path, values, &e)
+
+	if err != nil {
+		return nil, err
+	}
+
+	tz := e.TimeZone
+
+	if tz == "" {
+		tz = values.Get("timezone")
+	}
+
+	localize(&e.Data, tz)
+
+	if ctx.Value(rawResponseKey{}) == true {
+		e.Raw = body
+	}
+
+	return &e, nil
+}
+
+// getOne fetches an endpoint returning a single resource.
+func getOne[T any](ctx context.Context, c *HTTPClient, path string, values url.Values) (*T, *ResponseDetails, error) {
+	e, err := getEnvelope[*T](ctx, c, path, values)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e.Data, &e.ResponseDetails, nil
+}
+
+// getMany fetches an endpoint returning a list of resources.
+func getMany[T any](ctx context.Context, c *HTTPClient, path string, values url.Values) ([]T, *ResponseDetails, error) {
+	e, err := getEnvelope[[]T](ctx, c, path, values)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e.Data, &e.ResponseDetails, nil
 }
@@ -0,0 +1,80 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRawResponse(t *testing.T) {
+	url := defaultBaseURL + "/football/coaches/2?api_token=api-key"
+
+	t.Run("keeps the full response body within response details", func(t *testing.T) {
+		server := mockResponseServer(t, coachResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		coach, details, err := client.CoachByID(WithRawResponse(context.Background()), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertCoach(t, coach)
+		assert.JSONEq(t, coachResponse, string(details.Raw))
+	})
+
+	t.Run("does not keep the response body by default", func(t *testing.T) {
+		server := mockResponseServer(t, coachResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.CoachByID(context.Background(), 2, nil)
+
+		assert.Nil(t, details.Raw)
+	})
+
+	t.Run("keeps the response body of cached responses", func(t *testing.T) {
+		calls := 0
+
+		client := newTestHTTPClient(countingServer(&calls, cachedVenueResponse))
+		client.SetCache(NewLRUCache(10), DefaultCacheTTLs())
+
+		_, _, _ = client.VenueByID(context.Background(), 8, nil)
+		_, details, _ := client.VenueByID(WithRawResponse(context.Background()), 8, nil)
+
+		assert.Equal(t, 1, calls)
+		assert.JSONEq(t, cachedVenueResponse, string(details.Raw))
+	})
+}
+
+func TestDecodeEnvelope(t *testing.T) {
+	t.Run("decodes data and response details", func(t *testing.T) {
+		envelope, err := DecodeEnvelope[[]Continent]([]byte(continentsResponse))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertContinent(t, &envelope.Data[0])
+		assertResponseDetails(t, &envelope.ResponseDetails, "Continent")
+	})
+
+	t.Run("decodes data into a custom type", func(t *testing.T) {
+		envelope, err := DecodeEnvelope[map[string]interface{}]([]byte(coachResponse))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "D. Unsworth", envelope.Data["common_name"])
+	})
+
+	t.Run("returns error for malformed body", func(t *testing.T) {
+		envelope, err := DecodeEnvelope[Coach]([]byte(`{"data": [`))
+
+		assert.Nil(t, envelope)
+		assert.NotNil(t, err)
+	})
+}
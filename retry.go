@@ -0,0 +1,129 @@
+package sportmonks
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how HTTPClient retries requests that fail with a transport error or a retryable status code.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request, including the first. Values less than 2
+	// disable retries.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry. Each subsequent retry doubles the previous delay.
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff delay. Zero means no cap.
+	MaxDelay time.Duration
+	// Jitter is the fraction, between 0 and 1, of each backoff delay that is randomised to avoid synchronised retries.
+	Jitter float64
+	// RetryableStatusCodes is the set of HTTP status codes that are retried.
+	RetryableStatusCodes map[int]bool
+	// PerAttemptTimeout bounds the duration of each individual attempt. Zero means attempts are bounded only by the
+	// request context.
+	PerAttemptTimeout time.Duration
+	// MaxRateLimitWait is the longest the client will wait for a rate limit to reset before retrying a 429 response.
+	// If the API asks the client to wait longer the rate limit error is returned. Zero means no limit.
+	MaxRateLimitWait time.Duration
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used by NewDefaultHTTPClient.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   500 * time.Millisecond,
+		MaxDelay:    10 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: map[int]bool{
+			http.StatusTooManyRequests:     true,
+			http.StatusInternalServerError: true,
+			http.StatusBadGateway:          true,
+			http.StatusServiceUnavailable:  true,
+			http.StatusGatewayTimeout:      true,
+		},
+		MaxRateLimitWait: time.Minute,
+	}
+}
+
+func (p *RetryPolicy) attempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// backoff returns the delay before the given retry, where retry 1 is the first retry.
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(retry-1))
+
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+
+	if p.Jitter > 0 {
+		delay -= delay * math.Min(p.Jitter, 1) * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+// retryDelay reports whether a failed attempt should be retried and how long to wait before doing so.
+func (p *RetryPolicy) retryDelay(retry int, status int, header http.Header, err error) (time.Duration, bool) {
+	if status == 0 {
+		return p.backoff(retry), true
+	}
+
+	if !p.RetryableStatusCodes[status] {
+		return 0, false
+	}
+
+	if status == http.StatusTooManyRequests {
+		if wait, ok := rateLimitWait(header, err); ok {
+			if p.MaxRateLimitWait > 0 && wait > p.MaxRateLimitWait {
+				return 0, false
+			}
+
+			return wait, true
+		}
+	}
+
+	return p.backoff(retry), true
+}
+
+// rateLimitWait extracts how long the API has asked the client to wait from a 429 response, preferring the reset
+// window reported in the response body over the Retry-After header.
+func rateLimitWait(header http.Header, err error) (time.Duration, bool) {
+	var e *ErrRateLimit
+
+	if errors.As(err, &e) && e.RateLimit != nil && e.RateLimit.ResetsInSeconds > 0 {
+		return time.Duration(e.RateLimit.ResetsInSeconds) * time.Second, true
+	}
+
+	if s, e := strconv.Atoi(header.Get("Retry-After")); e == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < d {
+		return context.DeadlineExceeded
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
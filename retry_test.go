@@ -0,0 +1,268 @@
+package sportmonks
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var rateLimitResetErrorResponse = `{
+	"message": "You have reached your rate limit",
+	"link": "https:\/\/docs.sportmonks.com\/football\/api\/response-codes\/other-exceptions",
+	"reset_code": "c9920a03f111f49848fad06608df63ed",
+	"rate_limit": {
+		"resets_in_seconds": 12,
+		"remaining": 0,
+		"requested_entity": "Coach"
+	}
+}`
+
+func sequenceServer(calls *int, responses ...*http.Response) *http.Client {
+	return newTestClient(func(req *http.Request) *http.Response {
+		resp := responses[*calls]
+		*calls++
+		return resp
+	})
+}
+
+func stringResponse(code int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       io.NopCloser(bytes.NewBufferString(body)),
+	}
+}
+
+func newRetryTestHTTPClient(server *http.Client, waits *[]time.Duration) *HTTPClient {
+	client := newTestHTTPClient(server)
+
+	client.RetryPolicy = &RetryPolicy{
+		MaxAttempts:          3,
+		BaseDelay:            100 * time.Millisecond,
+		MaxDelay:             150 * time.Millisecond,
+		RetryableStatusCodes: DefaultRetryPolicy().RetryableStatusCodes,
+		MaxRateLimitWait:     time.Minute,
+	}
+
+	client.sleep = func(ctx context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+
+	return client
+}
+
+func TestRetryPolicy(t *testing.T) {
+	t.Run("retries server errors with exponential backoff", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := sequenceServer(
+			&calls,
+			stringResponse(500, errorResponse),
+			stringResponse(503, errorResponse),
+			stringResponse(200, coachResponse),
+		)
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		coach, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertCoach(t, coach)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, []time.Duration{100 * time.Millisecond, 150 * time.Millisecond}, waits)
+	})
+
+	t.Run("returns last error once attempts are exhausted", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := sequenceServer(
+			&calls,
+			stringResponse(502, errorResponse),
+			stringResponse(502, errorResponse),
+			stringResponse(502, errorResponse),
+		)
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		assertError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, 2, len(waits))
+	})
+
+	t.Run("does not retry non retryable status codes", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := sequenceServer(&calls, stringResponse(404, errorResponse))
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		assertError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 0, len(waits))
+	})
+
+	t.Run("retries transport errors", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := &http.Client{
+			Transport: roundTripErrFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+
+				if calls == 1 {
+					return nil, errors.New("connection reset by peer")
+				}
+
+				return stringResponse(200, coachResponse), nil
+			}),
+		}
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		coach, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertCoach(t, coach)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("waits for rate limit reset reported in the response body", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := sequenceServer(
+			&calls,
+			stringResponse(429, rateLimitResetErrorResponse),
+			stringResponse(200, coachResponse),
+		)
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Duration{12 * time.Second}, waits)
+	})
+
+	t.Run("waits for duration in retry after header", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		limited := stringResponse(429, rateLimitErrorResponse)
+		limited.Header.Set("Retry-After", "3")
+
+		server := sequenceServer(&calls, limited, stringResponse(200, coachResponse))
+
+		client := newRetryTestHTTPClient(server, &waits)
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []time.Duration{3 * time.Second}, waits)
+	})
+
+	t.Run("returns rate limit error when reset exceeds maximum wait", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := sequenceServer(&calls, stringResponse(429, rateLimitResetErrorResponse))
+
+		client := newRetryTestHTTPClient(server, &waits)
+		client.RetryPolicy.MaxRateLimitWait = 5 * time.Second
+
+		_, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		var rateLimitErr *ErrRateLimit
+
+		assert.True(t, errors.As(err, &rateLimitErr))
+		assert.Equal(t, 12, rateLimitErr.RateLimit.ResetsInSeconds)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("does not wait beyond the context deadline", func(t *testing.T) {
+		calls := 0
+
+		server := sequenceServer(&calls, stringResponse(503, errorResponse), stringResponse(200, coachResponse))
+
+		client := newTestHTTPClient(server)
+		client.RetryPolicy = DefaultRetryPolicy()
+		client.RetryPolicy.BaseDelay = time.Hour
+		client.RetryPolicy.MaxDelay = 0
+		client.RetryPolicy.Jitter = 0
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, _, err := client.CoachByID(ctx, 2, nil)
+
+		assertError(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("applies timeout to each attempt", func(t *testing.T) {
+		calls := 0
+		var waits []time.Duration
+
+		server := &http.Client{
+			Transport: roundTripErrFunc(func(req *http.Request) (*http.Response, error) {
+				calls++
+
+				if calls == 1 {
+					<-req.Context().Done()
+					return nil, req.Context().Err()
+				}
+
+				return stringResponse(200, coachResponse), nil
+			}),
+		}
+
+		client := newRetryTestHTTPClient(server, &waits)
+		client.RetryPolicy.PerAttemptTimeout = 10 * time.Millisecond
+
+		coach, _, err := client.CoachByID(context.Background(), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertCoach(t, coach)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("applies jitter within configured fraction", func(t *testing.T) {
+		policy := &RetryPolicy{BaseDelay: time.Second, Jitter: 0.5}
+
+		for i := 0; i < 50; i++ {
+			d := policy.backoff(2)
+
+			assert.True(t, d <= 2*time.Second)
+			assert.True(t, d >= time.Second)
+		}
+	})
+}
+
+type roundTripErrFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripErrFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
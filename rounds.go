@@ -3,8 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 )
 
 // Round provides a struct representation of a Round resource.
@@ -17,52 +15,26 @@ type Round struct {
 	Name               string `json:"name"`
 	Finished           bool   `json:"finished"`
 	IsCurrent          bool   `json:"is_current"`
-	StartingAt         string `json:"starting_at"`
-	EndingAt           string `json:"ending_at"`
+	StartingAt         Date   `json:"starting_at"`
+	EndingAt           Date   `json:"ending_at"`
 	GamesInCurrentWeek bool   `json:"games_in_current_week"`
 }
 
-// RoundByID fetches a Round resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) RoundByID(ctx context.Context, id int, includes []string) (*Round, *Meta, error) {
+// RoundByID fetches a Round resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) RoundByID(ctx context.Context, id int, query *Query) (*Round, *ResponseDetails, error) {
 	path := fmt.Sprintf(roundsURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	values := query.Values()
 
-	response := struct {
-		Data *Round `json:"data"`
-		Meta *Meta  `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getOne[Round](ctx, c, path, values)
 }
 
-// RoundsBySeasonID fetches a Round resource associated to a Season by Season ID. Use the includes slice of string
-// to enrich the response data.
-func (c *HTTPClient) RoundsBySeasonID(ctx context.Context, id int, includes []string) ([]Round, *Meta, error) {
+// RoundsBySeasonID fetches a Round resource associated to a Season by Season ID. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) RoundsBySeasonID(ctx context.Context, id int, query *Query) ([]Round, *ResponseDetails, error) {
 	path := fmt.Sprintf(roundsSeasonURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data []Round `json:"data"`
-		Meta *Meta   `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, response.Meta, err
+	return getMany[Round](ctx, c, path, values)
 }
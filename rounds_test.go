@@ -19,7 +19,31 @@ var roundResponse = `{
       "starting_at": "2010-08-14",
       "ending_at": "2010-08-16",
       "games_in_current_week": false
-    }
+    },
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Round"
+	},
+	"timezone": "UTC"
 }`
 
 var roundIncludesResponse = `{
@@ -76,13 +100,13 @@ var roundsSeasonIncludesResponse = `{
 
 func TestRoundByID(t *testing.T) {
 	t.Run("return a single Round struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/rounds/100?api_token=api-key&include="
+		url := defaultBaseURL + "/football/rounds/100?api_token=api-key"
 
 		server := mockResponseServer(t, roundResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		round, _, err := client.RoundByID(context.Background(), 100, []string{})
+		round, _, err := client.RoundByID(context.Background(), 100, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -92,13 +116,13 @@ func TestRoundByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/rounds/100?api_token=api-key&include="
+		url := defaultBaseURL + "/football/rounds/100?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		round, _, err := client.RoundByID(context.Background(), 100, []string{})
+		round, _, err := client.RoundByID(context.Background(), 100, nil)
 
 		if round != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", round)
@@ -114,7 +138,7 @@ func TestRoundByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		round, _, err := client.RoundByID(context.Background(), 100, []string{"fixtures", "league", "results", "season"})
+		round, _, err := client.RoundByID(context.Background(), 100, NewQuery().Include("fixtures", "league", "results", "season"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -122,17 +146,29 @@ func TestRoundByID(t *testing.T) {
 
 		assertRound(t, round)
 	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		url := defaultBaseURL + "/football/rounds/100?api_token=api-key"
+
+		server := mockResponseServer(t, roundResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.RoundByID(context.Background(), 100, nil)
+
+		assertResponseDetails(t, details, "Round")
+	})
 }
 
 func TestRoundsBySeasonID(t *testing.T) {
 	t.Run("returns a slice of Round struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/rounds/seasons/16029?api_token=api-key&include="
+		url := defaultBaseURL + "/football/rounds/seasons/16029?api_token=api-key"
 
 		server := mockResponseServer(t, roundsSeasonResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, []string{})
+		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -148,7 +184,7 @@ func TestRoundsBySeasonID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, []string{"fixtures", "league", "results", "season"})
+		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, NewQuery().Include("fixtures", "league", "results", "season"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -158,13 +194,13 @@ func TestRoundsBySeasonID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/rounds/seasons/16029?api_token=api-key&include="
+		url := defaultBaseURL + "/football/rounds/seasons/16029?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, []string{})
+		rounds, _, err := client.RoundsBySeasonID(context.Background(), 16029, nil)
 
 		if rounds != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", rounds)
@@ -183,7 +219,7 @@ func assertRound(t *testing.T, round *Round) {
 	assert.Equal(t, "1", round.Name)
 	assert.Equal(t, true, round.Finished)
 	assert.Equal(t, false, round.IsCurrent)
-	assert.Equal(t, "2010-08-14", round.StartingAt)
-	assert.Equal(t, "2010-08-16", round.EndingAt)
+	assert.Equal(t, "2010-08-14", round.StartingAt.String())
+	assert.Equal(t, "2010-08-16", round.EndingAt.String())
 	assert.Equal(t, false, round.GamesInCurrentWeek)
 }
@@ -3,9 +3,8 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
+	"iter"
 	"strconv"
-	"strings"
 )
 
 // Season provides a struct representation of a Season resource.
@@ -18,8 +17,8 @@ type Season struct {
 	Finished                bool      `json:"finished"`
 	Pending                 bool      `json:"pending"`
 	IsCurrent               bool      `json:"is_current"`
-	StartingAt              string    `json:"starting_at"`
-	EndingAt                string    `json:"ending_at"`
+	StartingAt              Date      `json:"starting_at"`
+	EndingAt                Date      `json:"ending_at"`
 	StandingsRecalculatedAt string    `json:"standings_recalculated_at"`
 	GamesInCurrentWeek      bool      `json:"games_in_current_week"`
 	League                  *League   `json:"league,omitempty"`
@@ -30,60 +29,30 @@ type Season struct {
 
 // Seasons fetches Season resources. The endpoint used within this method is paginated, to select the required
 // page use the 'page' method argument. Pagination information including current page and count are included within
-// // the Pagination struct with the ResponseDetails struct. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) Seasons(ctx context.Context, page int, includes []string) ([]Season, *ResponseDetails, error) {
-	values := url.Values{
-		"page":    {strconv.Itoa(page)},
-		"include": {strings.Join(includes, ";")},
-	}
+// // the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Seasons(ctx context.Context, page int, query *Query) ([]Season, *ResponseDetails, error) {
+	values := query.Values()
 
-	response := struct {
-		Data         []Season       `json:"data"`
-		Pagination   Pagination     `json:"pagination"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
+	values.Set("page", strconv.Itoa(page))
 
-	err := c.getResource(ctx, seasonsURI, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	return getMany[Season](ctx, c, seasonsURI, values)
+}
 
-	return response.Data, &ResponseDetails{
-		Pagination:   &response.Pagination,
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+// SeasonsIter returns an iterator over Season resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) SeasonsIter(ctx context.Context, query *Query) iter.Seq2[Season, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Season, *ResponseDetails, error) {
+		return c.Seasons(ctx, page, query)
+	})
 }
 
-// SeasonByID fetches a Season resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) SeasonByID(ctx context.Context, id int, includes []string) (*Season, *ResponseDetails, error) {
+// SeasonByID fetches a Season resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) SeasonByID(ctx context.Context, id int, query *Query) (*Season, *ResponseDetails, error) {
 	path := fmt.Sprintf(seasonsURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-		"deleted": []string{"1"},
-	}
-
-	response := struct {
-		Data         *Season        `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
+	values := query.Values()
 
-	if err != nil {
-		return nil, nil, err
-	}
+	values.Set("deleted", "1")
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[Season](ctx, c, path, values)
 }
@@ -163,13 +163,13 @@ var seasonIncludesResponse = `{
 
 func TestSeasons(t *testing.T) {
 	t.Run("returns a slice of Season struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/football/seasons?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, seasonsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		seasons, _, err := client.Seasons(context.Background(), 1, []string{})
+		seasons, _, err := client.Seasons(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -185,7 +185,7 @@ func TestSeasons(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		seasons, _, err := client.Seasons(context.Background(), 1, []string{"league", "goalscorers", "rounds"})
+		seasons, _, err := client.Seasons(context.Background(), 1, NewQuery().Include("league", "goalscorers", "rounds"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -196,13 +196,13 @@ func TestSeasons(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/football/seasons?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		seasons, _, err := client.Seasons(context.Background(), 1, []string{})
+		seasons, _, err := client.Seasons(context.Background(), 1, nil)
 
 		if seasons != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", seasons)
@@ -212,13 +212,13 @@ func TestSeasons(t *testing.T) {
 	})
 
 	t.Run("can handle response details", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons?api_token=api-key&include=&page=1"
+		url := defaultBaseURL + "/football/seasons?api_token=api-key&page=1"
 
 		server := mockResponseServer(t, seasonsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.Seasons(context.Background(), 1, []string{})
+		_, details, _ := client.Seasons(context.Background(), 1, nil)
 
 		assertResponseDetails(t, details, "Season")
 	})
@@ -226,13 +226,13 @@ func TestSeasons(t *testing.T) {
 
 func TestSeasonByID(t *testing.T) {
 	t.Run("returns a single Season struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1&include="
+		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1"
 
 		server := mockResponseServer(t, seasonResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		season, _, err := client.SeasonByID(context.Background(), 55, []string{})
+		season, _, err := client.SeasonByID(context.Background(), 55, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -248,7 +248,7 @@ func TestSeasonByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		season, _, err := client.SeasonByID(context.Background(), 55, []string{"league", "goalscorers", "rounds", "results"})
+		season, _, err := client.SeasonByID(context.Background(), 55, NewQuery().Include("league", "goalscorers", "rounds", "results"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -259,13 +259,13 @@ func TestSeasonByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1&include="
+		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		season, _, err := client.SeasonByID(context.Background(), 55, []string{})
+		season, _, err := client.SeasonByID(context.Background(), 55, nil)
 
 		if season != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", season)
@@ -275,13 +275,13 @@ func TestSeasonByID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1&include="
+		url := defaultBaseURL + "/football/seasons/55?api_token=api-key&deleted=1"
 
 		server := mockResponseServer(t, seasonResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.SeasonByID(context.Background(), 55, []string{})
+		_, details, _ := client.SeasonByID(context.Background(), 55, nil)
 
 		assertResponseDetails(t, details, "Season")
 	})
@@ -296,8 +296,8 @@ func assertSeason(t *testing.T, season *Season) {
 	assert.Equal(t, true, season.Finished)
 	assert.Equal(t, false, season.Pending)
 	assert.Equal(t, false, season.IsCurrent)
-	assert.Equal(t, "2010-08-14", season.StartingAt)
-	assert.Equal(t, "2011-05-22", season.EndingAt)
+	assert.Equal(t, "2010-08-14", season.StartingAt.String())
+	assert.Equal(t, "2011-05-22", season.EndingAt.String())
 	assert.Equal(t, "2023-05-24 08:28:07", season.StandingsRecalculatedAt)
 	assert.Equal(t, false, season.GamesInCurrentWeek)
 }
@@ -0,0 +1,1298 @@
+// Package sportmonkstest provides implementations of the sportmonks.Client interface for use in tests, removing the
+// need to make HTTP requests to the API.
+package sportmonkstest
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+)
+
+const defaultPerPage = 25
+
+// Fake is an in-memory sportmonks.Client. Resources are seeded using the Add methods and returned by the endpoint
+// methods in the same way as the API: by ID, by date, paginated and with only the requested includes populated.
+// Lists are returned in ascending ID order. A Fake is safe for concurrent use.
+type Fake struct {
+	// PerPage is the number of resources returned per page by paginated methods when the query does not set one.
+	// Zero means 25.
+	PerPage int
+	// Now returns the current time, used to select fixtures taking place today. Nil means time.Now.
+	Now func() time.Time
+
+	mu                  sync.Mutex
+	bookmakers          map[int]sportmonks.Bookmaker
+	coaches             map[int]sportmonks.Coach
+	commentaries        []sportmonks.Commentary
+	continents          map[int]sportmonks.Continent
+	countries           map[int]sportmonks.Country
+	expectedGoals       map[int]sportmonks.ExpectedGoals
+	fixtures            map[int]sportmonks.Fixture
+	updatedFixtures     map[int]bool
+	updatedLivescores   map[int]bool
+	leagues             map[int]sportmonks.League
+	markets             map[int]sportmonks.Market
+	odds                map[int]sportmonks.PrematchOdds
+	updatedOdds         map[int]bool
+	inplayOdds          map[int]sportmonks.InplayOdds
+	updatedInplayOdds   map[int]bool
+	players             map[int]sportmonks.Player
+	probabilities       map[int]sportmonks.Probability
+	valueBets           map[int]sportmonks.ValueBet
+	predictability      map[int]sportmonks.Predictability
+	rounds              map[int]sportmonks.Round
+	seasons             map[int]sportmonks.Season
+	stages              map[int]sportmonks.Stage
+	standings           map[int]sportmonks.Standing
+	standingCorrections map[int]sportmonks.StandingCorrection
+	states              map[int]sportmonks.FixtureState
+	squads              map[[2]int][]sportmonks.SquadPlayer
+	currentSquads       map[int][]sportmonks.SquadPlayer
+	teams               map[int]sportmonks.Team
+	seasonTeams         map[int][]int
+	topScorers          map[int]sportmonks.TopScorer
+	tvStations          map[int][]sportmonks.TVStation
+	types               map[int]sportmonks.Type
+	entityTypes         map[string][]int
+	venues              map[int]sportmonks.Venue
+	seasonVenues        map[int][]int
+	errors              map[string]error
+}
+
+var _ sportmonks.Client = (*Fake)(nil)
+
+// NewFake creates a new Fake holding no resources.
+func NewFake() *Fake {
+	return &Fake{
+		bookmakers:          map[int]sportmonks.Bookmaker{},
+		coaches:             map[int]sportmonks.Coach{},
+		continents:          map[int]sportmonks.Continent{},
+		countries:           map[int]sportmonks.Country{},
+		expectedGoals:       map[int]sportmonks.ExpectedGoals{},
+		fixtures:            map[int]sportmonks.Fixture{},
+		updatedFixtures:     map[int]bool{},
+		updatedLivescores:   map[int]bool{},
+		leagues:             map[int]sportmonks.League{},
+		markets:             map[int]sportmonks.Market{},
+		odds:                map[int]sportmonks.PrematchOdds{},
+		updatedOdds:         map[int]bool{},
+		inplayOdds:          map[int]sportmonks.InplayOdds{},
+		updatedInplayOdds:   map[int]bool{},
+		players:             map[int]sportmonks.Player{},
+		probabilities:       map[int]sportmonks.Probability{},
+		valueBets:           map[int]sportmonks.ValueBet{},
+		predictability:      map[int]sportmonks.Predictability{},
+		rounds:              map[int]sportmonks.Round{},
+		seasons:             map[int]sportmonks.Season{},
+		stages:              map[int]sportmonks.Stage{},
+		standings:           map[int]sportmonks.Standing{},
+		standingCorrections: map[int]sportmonks.StandingCorrection{},
+		states:              map[int]sportmonks.FixtureState{},
+		squads:              map[[2]int][]sportmonks.SquadPlayer{},
+		currentSquads:       map[int][]sportmonks.SquadPlayer{},
+		teams:               map[int]sportmonks.Team{},
+		seasonTeams:         map[int][]int{},
+		topScorers:          map[int]sportmonks.TopScorer{},
+		tvStations:          map[int][]sportmonks.TVStation{},
+		types:               map[int]sportmonks.Type{},
+		entityTypes:         map[string][]int{},
+		venues:              map[int]sportmonks.Venue{},
+		seasonVenues:        map[int][]int{},
+		errors:              map[string]error{},
+	}
+}
+
+// SetError causes every call to the named method, e.g. "FixtureByID", to return err. A nil err removes the error.
+func (f *Fake) SetError(method string, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err == nil {
+		delete(f.errors, method)
+		return
+	}
+
+	f.errors[method] = err
+}
+
+// AddBookmakers seeds Bookmaker resources. BookmakersByFixtureID returns the bookmakers with seeded PrematchOdds for
+// the fixture.
+func (f *Fake) AddBookmakers(bookmakers ...sportmonks.Bookmaker) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, b := range bookmakers {
+		f.bookmakers[b.ID] = b
+	}
+}
+
+// AddCoaches seeds Coach resources.
+func (f *Fake) AddCoaches(coaches ...sportmonks.Coach) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, c := range coaches {
+		f.coaches[c.ID] = c
+	}
+}
+
+// AddCommentaries seeds Commentary resources, returned for the fixture matching their FixtureID.
+func (f *Fake) AddCommentaries(commentaries ...sportmonks.Commentary) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.commentaries = append(f.commentaries, commentaries...)
+}
+
+// AddContinents seeds Continent resources.
+func (f *Fake) AddContinents(continents ...sportmonks.Continent) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, c := range continents {
+		f.continents[c.ID] = c
+	}
+}
+
+// AddCountries seeds Country resources.
+func (f *Fake) AddCountries(countries ...sportmonks.Country) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, c := range countries {
+		f.countries[c.ID] = c
+	}
+}
+
+// AddExpectedGoals seeds ExpectedGoals records. Records with a PlayerID are returned by ExpectedGoalsByPlayer, the
+// remainder by ExpectedGoalsByTeam.
+func (f *Fake) AddExpectedGoals(records ...sportmonks.ExpectedGoals) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, x := range records {
+		f.expectedGoals[x.ID] = x
+	}
+}
+
+// AddFixtures seeds Fixture resources, replacing any fixture with the same ID. Added fixtures are returned by the next
+// call to LatestUpdatedFixtures and, when in play, LatestLivescores. Teams are matched to fixtures by Participants.
+func (f *Fake) AddFixtures(fixtures ...sportmonks.Fixture) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, fx := range fixtures {
+		f.fixtures[fx.ID] = fx
+		f.updatedFixtures[fx.ID] = true
+		f.updatedLivescores[fx.ID] = true
+	}
+}
+
+// AddLeagues seeds League resources.
+func (f *Fake) AddLeagues(leagues ...sportmonks.League) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, l := range leagues {
+		f.leagues[l.ID] = l
+	}
+}
+
+// AddMarkets seeds Market resources.
+func (f *Fake) AddMarkets(markets ...sportmonks.Market) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, m := range markets {
+		f.markets[m.ID] = m
+	}
+}
+
+// AddPrematchOdds seeds PrematchOdds resources, replacing any odds with the same ID. Added odds are returned by the
+// next call to LatestOdds.
+func (f *Fake) AddPrematchOdds(odds ...sportmonks.PrematchOdds) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, o := range odds {
+		f.odds[o.ID] = o
+		f.updatedOdds[o.ID] = true
+	}
+}
+
+// AddInplayOdds seeds InplayOdds resources, replacing any odds with the same ID. Added odds are returned by the next
+// call to LatestInplayOdds.
+func (f *Fake) AddInplayOdds(odds ...sportmonks.InplayOdds) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, o := range odds {
+		f.inplayOdds[o.ID] = o
+		f.updatedInplayOdds[o.ID] = true
+	}
+}
+
+// AddPlayers seeds Player resources.
+func (f *Fake) AddPlayers(players ...sportmonks.Player) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range players {
+		f.players[p.ID] = p
+	}
+}
+
+// AddProbabilities seeds Probability resources.
+func (f *Fake) AddProbabilities(probabilities ...sportmonks.Probability) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range probabilities {
+		f.probabilities[p.ID] = p
+	}
+}
+
+// AddValueBets seeds ValueBet resources.
+func (f *Fake) AddValueBets(bets ...sportmonks.ValueBet) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, b := range bets {
+		f.valueBets[b.ID] = b
+	}
+}
+
+// AddPredictability seeds Predictability resources, returned for the league matching their LeagueID.
+func (f *Fake) AddPredictability(predictability ...sportmonks.Predictability) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range predictability {
+		f.predictability[p.ID] = p
+	}
+}
+
+// AddRounds seeds Round resources.
+func (f *Fake) AddRounds(rounds ...sportmonks.Round) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, r := range rounds {
+		f.rounds[r.ID] = r
+	}
+}
+
+// AddSeasons seeds Season resources.
+func (f *Fake) AddSeasons(seasons ...sportmonks.Season) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range seasons {
+		f.seasons[s.ID] = s
+	}
+}
+
+// AddStages seeds Stage resources.
+func (f *Fake) AddStages(stages ...sportmonks.Stage) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range stages {
+		f.stages[s.ID] = s
+	}
+}
+
+// AddStandings seeds Standing resources. Live standings for a league are the standings of its current season, or all
+// standings of the league when no current season has been seeded.
+func (f *Fake) AddStandings(standings ...sportmonks.Standing) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range standings {
+		f.standings[s.ID] = s
+	}
+}
+
+// AddStandingCorrections seeds StandingCorrection resources.
+func (f *Fake) AddStandingCorrections(corrections ...sportmonks.StandingCorrection) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, c := range corrections {
+		f.standingCorrections[c.ID] = c
+	}
+}
+
+// AddStates seeds FixtureState resources.
+func (f *Fake) AddStates(states ...sportmonks.FixtureState) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range states {
+		f.states[s.ID] = s
+	}
+}
+
+// AddSquad seeds the SquadPlayer resources of a season, each returned for the team matching their TeamID.
+func (f *Fake) AddSquad(seasonID int, players ...sportmonks.SquadPlayer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range players {
+		key := [2]int{seasonID, p.TeamID}
+		f.squads[key] = append(f.squads[key], p)
+	}
+}
+
+// AddCurrentSquad seeds the current SquadPlayer resources, each returned for the team matching their TeamID.
+func (f *Fake) AddCurrentSquad(players ...sportmonks.SquadPlayer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, p := range players {
+		f.currentSquads[p.TeamID] = append(f.currentSquads[p.TeamID], p)
+	}
+}
+
+// AddTeams seeds Team resources.
+func (f *Fake) AddTeams(teams ...sportmonks.Team) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range teams {
+		f.teams[t.ID] = t
+	}
+}
+
+// AddSeasonTeams seeds Team resources taking part in a season.
+func (f *Fake) AddSeasonTeams(seasonID int, teams ...sportmonks.Team) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range teams {
+		f.teams[t.ID] = t
+		f.seasonTeams[seasonID] = append(f.seasonTeams[seasonID], t.ID)
+	}
+}
+
+// AddTopScorers seeds TopScorer resources.
+func (f *Fake) AddTopScorers(scorers ...sportmonks.TopScorer) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, s := range scorers {
+		f.topScorers[s.ID] = s
+	}
+}
+
+// AddTVStations seeds TVStation resources broadcasting a fixture.
+func (f *Fake) AddTVStations(fixtureID int, stations ...sportmonks.TVStation) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.tvStations[fixtureID] = append(f.tvStations[fixtureID], stations...)
+}
+
+// AddTypes seeds Type resources.
+func (f *Fake) AddTypes(types ...sportmonks.Type) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range types {
+		f.types[t.ID] = t
+	}
+}
+
+// AddEntityTypes seeds Type resources used by an entity such as 'FixtureStatistic', returned by TypesByEntity.
+func (f *Fake) AddEntityTypes(entity string, types ...sportmonks.Type) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, t := range types {
+		f.types[t.ID] = t
+
+		if !slices.Contains(f.entityTypes[entity], t.ID) {
+			f.entityTypes[entity] = append(f.entityTypes[entity], t.ID)
+		}
+	}
+}
+
+// AddVenues seeds Venue resources.
+func (f *Fake) AddVenues(venues ...sportmonks.Venue) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, v := range venues {
+		f.venues[v.ID] = v
+	}
+}
+
+// AddSeasonVenues seeds Venue resources used within a season.
+func (f *Fake) AddSeasonVenues(seasonID int, venues ...sportmonks.Venue) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, v := range venues {
+		f.venues[v.ID] = v
+		f.seasonVenues[seasonID] = append(f.seasonVenues[seasonID], v.ID)
+	}
+}
+
+// Bookmakers returns a page of the seeded Bookmaker resources.
+func (f *Fake) Bookmakers(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Bookmaker, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Bookmakers", query, page, func() []sportmonks.Bookmaker {
+		return sorted(f.bookmakers)
+	})
+}
+
+// BookmakersIter returns an iterator over the seeded Bookmaker resources.
+func (f *Fake) BookmakersIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Bookmaker, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Bookmaker, *sportmonks.ResponseDetails, error) {
+		return f.Bookmakers(ctx, page, query)
+	})
+}
+
+// BookmakerByID returns the seeded Bookmaker with the given ID.
+func (f *Fake) BookmakerByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Bookmaker, *sportmonks.ResponseDetails, error) {
+	return one(f, "BookmakerByID", f.bookmakers, id, query)
+}
+
+// BookmakersByFixtureID returns the seeded Bookmaker resources with seeded PrematchOdds for a fixture.
+func (f *Fake) BookmakersByFixtureID(ctx context.Context, fixtureID int, query *sportmonks.Query) ([]sportmonks.Bookmaker, *sportmonks.ResponseDetails, error) {
+	return all(f, "BookmakersByFixtureID", query, func() []sportmonks.Bookmaker {
+		offering := map[int]bool{}
+
+		for _, o := range f.odds {
+			if o.FixtureID == fixtureID {
+				offering[o.BookmakerID] = true
+			}
+		}
+
+		return filter(sorted(f.bookmakers), func(b sportmonks.Bookmaker) bool { return offering[b.ID] })
+	})
+}
+
+// BookmakerSearch returns the seeded Bookmaker resources with a name containing the search term, ignoring case.
+func (f *Fake) BookmakerSearch(ctx context.Context, name string, query *sportmonks.Query) ([]sportmonks.Bookmaker, *sportmonks.ResponseDetails, error) {
+	return all(f, "BookmakerSearch", query, func() []sportmonks.Bookmaker {
+		return filter(sorted(f.bookmakers), func(b sportmonks.Bookmaker) bool { return matches(b.Name, name) })
+	})
+}
+
+// CoachByID returns the seeded Coach with the given ID.
+func (f *Fake) CoachByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Coach, *sportmonks.ResponseDetails, error) {
+	return one(f, "CoachByID", f.coaches, id, query)
+}
+
+// CommentariesByFixtureID returns the seeded Commentary resources for a fixture.
+func (f *Fake) CommentariesByFixtureID(ctx context.Context, fixtureID int, query *sportmonks.Query) ([]sportmonks.Commentary, *sportmonks.ResponseDetails, error) {
+	return all(f, "CommentariesByFixtureID", query, func() []sportmonks.Commentary {
+		return filter(f.commentaries, func(c sportmonks.Commentary) bool { return c.FixtureID == fixtureID })
+	})
+}
+
+// Continents returns a page of the seeded Continent resources.
+func (f *Fake) Continents(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Continent, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Continents", query, page, func() []sportmonks.Continent {
+		return sorted(f.continents)
+	})
+}
+
+// ContinentsIter returns an iterator over the seeded Continent resources.
+func (f *Fake) ContinentsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Continent, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Continent, *sportmonks.ResponseDetails, error) {
+		return f.Continents(ctx, page, query)
+	})
+}
+
+// ContinentByID returns the seeded Continent with the given ID.
+func (f *Fake) ContinentByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Continent, *sportmonks.ResponseDetails, error) {
+	return one(f, "ContinentByID", f.continents, id, query)
+}
+
+// Countries returns a page of the seeded Country resources.
+func (f *Fake) Countries(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Country, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Countries", query, page, func() []sportmonks.Country {
+		return sorted(f.countries)
+	})
+}
+
+// CountriesIter returns an iterator over the seeded Country resources.
+func (f *Fake) CountriesIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Country, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Country, *sportmonks.ResponseDetails, error) {
+		return f.Countries(ctx, page, query)
+	})
+}
+
+// CountryByID returns the seeded Country with the given ID.
+func (f *Fake) CountryByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Country, *sportmonks.ResponseDetails, error) {
+	return one(f, "CountryByID", f.countries, id, query)
+}
+
+// ExpectedGoalsByTeam returns a page of the seeded team ExpectedGoals records.
+func (f *Fake) ExpectedGoalsByTeam(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.ExpectedGoals, *sportmonks.ResponseDetails, error) {
+	return paged(f, "ExpectedGoalsByTeam", query, page, func() []sportmonks.ExpectedGoals {
+		return filter(sorted(f.expectedGoals), func(x sportmonks.ExpectedGoals) bool { return x.PlayerID == 0 })
+	})
+}
+
+// ExpectedGoalsByTeamIter returns an iterator over the seeded team ExpectedGoals records.
+func (f *Fake) ExpectedGoalsByTeamIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.ExpectedGoals, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.ExpectedGoals, *sportmonks.ResponseDetails, error) {
+		return f.ExpectedGoalsByTeam(ctx, page, query)
+	})
+}
+
+// ExpectedGoalsByPlayer returns a page of the seeded player ExpectedGoals records.
+func (f *Fake) ExpectedGoalsByPlayer(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.ExpectedGoals, *sportmonks.ResponseDetails, error) {
+	return paged(f, "ExpectedGoalsByPlayer", query, page, func() []sportmonks.ExpectedGoals {
+		return filter(sorted(f.expectedGoals), func(x sportmonks.ExpectedGoals) bool { return x.PlayerID != 0 })
+	})
+}
+
+// ExpectedGoalsByPlayerIter returns an iterator over the seeded player ExpectedGoals records.
+func (f *Fake) ExpectedGoalsByPlayerIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.ExpectedGoals, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.ExpectedGoals, *sportmonks.ResponseDetails, error) {
+		return f.ExpectedGoalsByPlayer(ctx, page, query)
+	})
+}
+
+// FixtureByID returns the seeded Fixture with the given ID.
+func (f *Fake) FixtureByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return one(f, "FixtureByID", f.fixtures, id, query)
+}
+
+// FixturesByID returns a page of the seeded Fixture resources with the given IDs.
+func (f *Fake) FixturesByID(ctx context.Context, ids []int, query *sportmonks.Query, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return paged(f, "FixturesByID", query, page, func() []sportmonks.Fixture {
+		return filter(sorted(f.fixtures), func(fx sportmonks.Fixture) bool { return slices.Contains(ids, fx.ID) })
+	})
+}
+
+// FixturesByIDIter returns an iterator over the seeded Fixture resources with the given IDs.
+func (f *Fake) FixturesByIDIter(ctx context.Context, ids []int, query *sportmonks.Query) iter.Seq2[sportmonks.Fixture, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+		return f.FixturesByID(ctx, ids, query, page)
+	})
+}
+
+// FixturesByDate returns a page of the seeded Fixture resources starting on the given date.
+func (f *Fake) FixturesByDate(ctx context.Context, date time.Time, query *sportmonks.Query, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return paged(f, "FixturesByDate", query, page, func() []sportmonks.Fixture {
+		return f.fixturesBetween(date, date, 0)
+	})
+}
+
+// FixturesByDateIter returns an iterator over the seeded Fixture resources starting on the given date.
+func (f *Fake) FixturesByDateIter(ctx context.Context, date time.Time, query *sportmonks.Query) iter.Seq2[sportmonks.Fixture, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+		return f.FixturesByDate(ctx, date, query, page)
+	})
+}
+
+// FixturesBetween returns a page of the seeded Fixture resources starting between the given dates inclusive.
+func (f *Fake) FixturesBetween(ctx context.Context, from, to time.Time, query *sportmonks.Query, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return paged(f, "FixturesBetween", query, page, func() []sportmonks.Fixture {
+		return f.fixturesBetween(from, to, 0)
+	})
+}
+
+// FixturesBetweenIter returns an iterator over the seeded Fixture resources starting between the given dates
+// inclusive.
+func (f *Fake) FixturesBetweenIter(ctx context.Context, from, to time.Time, query *sportmonks.Query) iter.Seq2[sportmonks.Fixture, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+		return f.FixturesBetween(ctx, from, to, query, page)
+	})
+}
+
+// FixturesBetweenForTeam returns a page of the seeded Fixture resources starting between the given dates inclusive in
+// which the team participates.
+func (f *Fake) FixturesBetweenForTeam(ctx context.Context, from, to time.Time, page, teamID int, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return paged(f, "FixturesBetweenForTeam", query, page, func() []sportmonks.Fixture {
+		return f.fixturesBetween(from, to, teamID)
+	})
+}
+
+// FixturesBetweenForTeamIter returns an iterator over the seeded Fixture resources starting between the given dates
+// inclusive in which the team participates.
+func (f *Fake) FixturesBetweenForTeamIter(ctx context.Context, from, to time.Time, teamID int, query *sportmonks.Query) iter.Seq2[sportmonks.Fixture, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+		return f.FixturesBetweenForTeam(ctx, from, to, page, teamID, query)
+	})
+}
+
+// HeadToHead returns a page of the seeded Fixture resources in which both teams participate.
+func (f *Fake) HeadToHead(ctx context.Context, idOne, idTwo int, query *sportmonks.Query, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return paged(f, "HeadToHead", query, page, func() []sportmonks.Fixture {
+		return filter(sorted(f.fixtures), func(fx sportmonks.Fixture) bool {
+			return participates(fx, idOne) && participates(fx, idTwo)
+		})
+	})
+}
+
+// HeadToHeadIter returns an iterator over the seeded Fixture resources in which both teams participate.
+func (f *Fake) HeadToHeadIter(ctx context.Context, idOne, idTwo int, query *sportmonks.Query) iter.Seq2[sportmonks.Fixture, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+		return f.HeadToHead(ctx, idOne, idTwo, query, page)
+	})
+}
+
+// LatestUpdatedFixtures returns the Fixture resources added since the previous call.
+func (f *Fake) LatestUpdatedFixtures(ctx context.Context, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return all(f, "LatestUpdatedFixtures", query, func() []sportmonks.Fixture {
+		return f.takeUpdated(f.updatedFixtures, func(sportmonks.Fixture) bool { return true })
+	})
+}
+
+// Leagues returns a page of the seeded League resources.
+func (f *Fake) Leagues(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.League, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Leagues", query, page, func() []sportmonks.League {
+		return sorted(f.leagues)
+	})
+}
+
+// LeaguesIter returns an iterator over the seeded League resources.
+func (f *Fake) LeaguesIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.League, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.League, *sportmonks.ResponseDetails, error) {
+		return f.Leagues(ctx, page, query)
+	})
+}
+
+// LeagueByID returns the seeded League with the given ID.
+func (f *Fake) LeagueByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.League, *sportmonks.ResponseDetails, error) {
+	return one(f, "LeagueByID", f.leagues, id, query)
+}
+
+// Livescores returns the seeded Fixture resources starting today.
+func (f *Fake) Livescores(ctx context.Context, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return all(f, "Livescores", query, func() []sportmonks.Fixture {
+		return f.fixturesBetween(f.now(), f.now(), 0)
+	})
+}
+
+// InplayLivescores returns the seeded Fixture resources currently in play.
+func (f *Fake) InplayLivescores(ctx context.Context, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return all(f, "InplayLivescores", query, func() []sportmonks.Fixture {
+		return filter(sorted(f.fixtures), inPlay)
+	})
+}
+
+// LatestLivescores returns the Fixture resources in play that were added since the previous call.
+func (f *Fake) LatestLivescores(ctx context.Context, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	return all(f, "LatestLivescores", query, func() []sportmonks.Fixture {
+		return f.takeUpdated(f.updatedLivescores, inPlay)
+	})
+}
+
+// AllPrematchOdds returns a page of the seeded PrematchOdds resources.
+func (f *Fake) AllPrematchOdds(ctx context.Context, query *sportmonks.Query, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "AllPrematchOdds", query, page, func() []sportmonks.PrematchOdds {
+		return sorted(f.odds)
+	})
+}
+
+// AllPrematchOddsIter returns an iterator over the seeded PrematchOdds resources.
+func (f *Fake) AllPrematchOddsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.PrematchOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+		return f.AllPrematchOdds(ctx, query, page)
+	})
+}
+
+// PrematchOddsByFixtureID returns a page of the seeded PrematchOdds resources for a fixture.
+func (f *Fake) PrematchOddsByFixtureID(ctx context.Context, id int, query *sportmonks.Query, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "PrematchOddsByFixtureID", query, page, func() []sportmonks.PrematchOdds {
+		return filter(sorted(f.odds), func(o sportmonks.PrematchOdds) bool { return o.FixtureID == id })
+	})
+}
+
+// PrematchOddsByFixtureIDIter returns an iterator over the seeded PrematchOdds resources for a fixture.
+func (f *Fake) PrematchOddsByFixtureIDIter(ctx context.Context, id int, query *sportmonks.Query) iter.Seq2[sportmonks.PrematchOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+		return f.PrematchOddsByFixtureID(ctx, id, query, page)
+	})
+}
+
+// PrematchOddsByFixtureIDAndBookmakerID returns a page of the seeded PrematchOdds resources for a fixture and
+// bookmaker.
+func (f *Fake) PrematchOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *sportmonks.Query, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "PrematchOddsByFixtureIDAndBookmakerID", query, page, func() []sportmonks.PrematchOdds {
+		return filter(sorted(f.odds), func(o sportmonks.PrematchOdds) bool {
+			return o.FixtureID == fixtureID && o.BookmakerID == bookmakerID
+		})
+	})
+}
+
+// PrematchOddsByFixtureIDAndBookmakerIDIter returns an iterator over the seeded PrematchOdds resources for a fixture
+// and bookmaker.
+func (f *Fake) PrematchOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *sportmonks.Query) iter.Seq2[sportmonks.PrematchOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+		return f.PrematchOddsByFixtureIDAndBookmakerID(ctx, fixtureID, bookmakerID, query, page)
+	})
+}
+
+// PrematchOddsByFixtureIDAndMarketID returns a page of the seeded PrematchOdds resources for a fixture and market.
+func (f *Fake) PrematchOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *sportmonks.Query, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "PrematchOddsByFixtureIDAndMarketID", query, page, func() []sportmonks.PrematchOdds {
+		return filter(sorted(f.odds), func(o sportmonks.PrematchOdds) bool {
+			return o.FixtureID == fixtureID && o.MarketID == marketID
+		})
+	})
+}
+
+// PrematchOddsByFixtureIDAndMarketIDIter returns an iterator over the seeded PrematchOdds resources for a fixture and
+// market.
+func (f *Fake) PrematchOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *sportmonks.Query) iter.Seq2[sportmonks.PrematchOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+		return f.PrematchOddsByFixtureIDAndMarketID(ctx, fixtureID, marketID, query, page)
+	})
+}
+
+// LatestOdds returns the PrematchOdds resources added since the previous call.
+func (f *Fake) LatestOdds(ctx context.Context, query *sportmonks.Query) ([]sportmonks.PrematchOdds, *sportmonks.ResponseDetails, error) {
+	return all(f, "LatestOdds", query, func() []sportmonks.PrematchOdds {
+		return takeMarked(f.odds, f.updatedOdds)
+	})
+}
+
+// AllInplayOdds returns a page of the seeded InplayOdds resources.
+func (f *Fake) AllInplayOdds(ctx context.Context, query *sportmonks.Query, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "AllInplayOdds", query, page, func() []sportmonks.InplayOdds {
+		return sorted(f.inplayOdds)
+	})
+}
+
+// AllInplayOddsIter returns an iterator over the seeded InplayOdds resources.
+func (f *Fake) AllInplayOddsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.InplayOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+		return f.AllInplayOdds(ctx, query, page)
+	})
+}
+
+// InplayOddsByFixtureID returns a page of the seeded InplayOdds resources for a fixture.
+func (f *Fake) InplayOddsByFixtureID(ctx context.Context, id int, query *sportmonks.Query, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "InplayOddsByFixtureID", query, page, func() []sportmonks.InplayOdds {
+		return filter(sorted(f.inplayOdds), func(o sportmonks.InplayOdds) bool { return o.FixtureID == id })
+	})
+}
+
+// InplayOddsByFixtureIDIter returns an iterator over the seeded InplayOdds resources for a fixture.
+func (f *Fake) InplayOddsByFixtureIDIter(ctx context.Context, id int, query *sportmonks.Query) iter.Seq2[sportmonks.InplayOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+		return f.InplayOddsByFixtureID(ctx, id, query, page)
+	})
+}
+
+// InplayOddsByFixtureIDAndBookmakerID returns a page of the seeded InplayOdds resources for a fixture and bookmaker.
+func (f *Fake) InplayOddsByFixtureIDAndBookmakerID(ctx context.Context, fixtureID, bookmakerID int, query *sportmonks.Query, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "InplayOddsByFixtureIDAndBookmakerID", query, page, func() []sportmonks.InplayOdds {
+		return filter(sorted(f.inplayOdds), func(o sportmonks.InplayOdds) bool {
+			return o.FixtureID == fixtureID && o.BookmakerID == bookmakerID
+		})
+	})
+}
+
+// InplayOddsByFixtureIDAndBookmakerIDIter returns an iterator over the seeded InplayOdds resources for a fixture and
+// bookmaker.
+func (f *Fake) InplayOddsByFixtureIDAndBookmakerIDIter(ctx context.Context, fixtureID, bookmakerID int, query *sportmonks.Query) iter.Seq2[sportmonks.InplayOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+		return f.InplayOddsByFixtureIDAndBookmakerID(ctx, fixtureID, bookmakerID, query, page)
+	})
+}
+
+// InplayOddsByFixtureIDAndMarketID returns a page of the seeded InplayOdds resources for a fixture and market.
+func (f *Fake) InplayOddsByFixtureIDAndMarketID(ctx context.Context, fixtureID, marketID int, query *sportmonks.Query, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+	return paged(f, "InplayOddsByFixtureIDAndMarketID", query, page, func() []sportmonks.InplayOdds {
+		return filter(sorted(f.inplayOdds), func(o sportmonks.InplayOdds) bool {
+			return o.FixtureID == fixtureID && o.MarketID == marketID
+		})
+	})
+}
+
+// InplayOddsByFixtureIDAndMarketIDIter returns an iterator over the seeded InplayOdds resources for a fixture and
+// market.
+func (f *Fake) InplayOddsByFixtureIDAndMarketIDIter(ctx context.Context, fixtureID, marketID int, query *sportmonks.Query) iter.Seq2[sportmonks.InplayOdds, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+		return f.InplayOddsByFixtureIDAndMarketID(ctx, fixtureID, marketID, query, page)
+	})
+}
+
+// LatestInplayOdds returns the InplayOdds resources added since the previous call.
+func (f *Fake) LatestInplayOdds(ctx context.Context, query *sportmonks.Query) ([]sportmonks.InplayOdds, *sportmonks.ResponseDetails, error) {
+	return all(f, "LatestInplayOdds", query, func() []sportmonks.InplayOdds {
+		return takeMarked(f.inplayOdds, f.updatedInplayOdds)
+	})
+}
+
+// Markets returns a page of the seeded Market resources.
+func (f *Fake) Markets(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Market, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Markets", query, page, func() []sportmonks.Market {
+		return sorted(f.markets)
+	})
+}
+
+// MarketsIter returns an iterator over the seeded Market resources.
+func (f *Fake) MarketsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Market, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Market, *sportmonks.ResponseDetails, error) {
+		return f.Markets(ctx, page, query)
+	})
+}
+
+// MarketByID returns the seeded Market with the given ID.
+func (f *Fake) MarketByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Market, *sportmonks.ResponseDetails, error) {
+	return one(f, "MarketByID", f.markets, id, query)
+}
+
+// MarketSearch returns the seeded Market resources with a name containing the search term, ignoring case.
+func (f *Fake) MarketSearch(ctx context.Context, name string, query *sportmonks.Query) ([]sportmonks.Market, *sportmonks.ResponseDetails, error) {
+	return all(f, "MarketSearch", query, func() []sportmonks.Market {
+		return filter(sorted(f.markets), func(m sportmonks.Market) bool { return matches(m.Name, name) })
+	})
+}
+
+// PlayerByID returns the seeded Player with the given ID.
+func (f *Fake) PlayerByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Player, *sportmonks.ResponseDetails, error) {
+	return one(f, "PlayerByID", f.players, id, query)
+}
+
+// Probabilities returns a page of the seeded Probability resources.
+func (f *Fake) Probabilities(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Probability, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Probabilities", query, page, func() []sportmonks.Probability {
+		return sorted(f.probabilities)
+	})
+}
+
+// ProbabilitiesIter returns an iterator over the seeded Probability resources.
+func (f *Fake) ProbabilitiesIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Probability, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Probability, *sportmonks.ResponseDetails, error) {
+		return f.Probabilities(ctx, page, query)
+	})
+}
+
+// ProbabilitiesByFixtureID returns the seeded Probability resources of a fixture.
+func (f *Fake) ProbabilitiesByFixtureID(ctx context.Context, fixtureID int, query *sportmonks.Query) ([]sportmonks.Probability, *sportmonks.ResponseDetails, error) {
+	return all(f, "ProbabilitiesByFixtureID", query, func() []sportmonks.Probability {
+		return filter(sorted(f.probabilities), func(p sportmonks.Probability) bool { return p.FixtureID == fixtureID })
+	})
+}
+
+// ValueBets returns a page of the seeded ValueBet resources.
+func (f *Fake) ValueBets(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.ValueBet, *sportmonks.ResponseDetails, error) {
+	return paged(f, "ValueBets", query, page, func() []sportmonks.ValueBet {
+		return sorted(f.valueBets)
+	})
+}
+
+// ValueBetsIter returns an iterator over the seeded ValueBet resources.
+func (f *Fake) ValueBetsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.ValueBet, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.ValueBet, *sportmonks.ResponseDetails, error) {
+		return f.ValueBets(ctx, page, query)
+	})
+}
+
+// ValueBetsByFixtureID returns the seeded ValueBet resources of a fixture.
+func (f *Fake) ValueBetsByFixtureID(ctx context.Context, fixtureID int, query *sportmonks.Query) ([]sportmonks.ValueBet, *sportmonks.ResponseDetails, error) {
+	return all(f, "ValueBetsByFixtureID", query, func() []sportmonks.ValueBet {
+		return filter(sorted(f.valueBets), func(b sportmonks.ValueBet) bool { return b.FixtureID == fixtureID })
+	})
+}
+
+// PredictabilityByLeagueID returns the seeded Predictability resources of a league.
+func (f *Fake) PredictabilityByLeagueID(ctx context.Context, leagueID int, query *sportmonks.Query) ([]sportmonks.Predictability, *sportmonks.ResponseDetails, error) {
+	return all(f, "PredictabilityByLeagueID", query, func() []sportmonks.Predictability {
+		return filter(sorted(f.predictability), func(p sportmonks.Predictability) bool { return p.LeagueID == leagueID })
+	})
+}
+
+// RoundByID returns the seeded Round with the given ID.
+func (f *Fake) RoundByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Round, *sportmonks.ResponseDetails, error) {
+	return one(f, "RoundByID", f.rounds, id, query)
+}
+
+// RoundsBySeasonID returns the seeded Round resources of a season.
+func (f *Fake) RoundsBySeasonID(ctx context.Context, id int, query *sportmonks.Query) ([]sportmonks.Round, *sportmonks.ResponseDetails, error) {
+	return all(f, "RoundsBySeasonID", query, func() []sportmonks.Round {
+		return filter(sorted(f.rounds), func(r sportmonks.Round) bool { return r.SeasonID == id })
+	})
+}
+
+// Seasons returns a page of the seeded Season resources.
+func (f *Fake) Seasons(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Season, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Seasons", query, page, func() []sportmonks.Season {
+		return sorted(f.seasons)
+	})
+}
+
+// SeasonsIter returns an iterator over the seeded Season resources.
+func (f *Fake) SeasonsIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Season, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Season, *sportmonks.ResponseDetails, error) {
+		return f.Seasons(ctx, page, query)
+	})
+}
+
+// SeasonByID returns the seeded Season with the given ID.
+func (f *Fake) SeasonByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Season, *sportmonks.ResponseDetails, error) {
+	return one(f, "SeasonByID", f.seasons, id, query)
+}
+
+// StageByID returns the seeded Stage with the given ID.
+func (f *Fake) StageByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Stage, *sportmonks.ResponseDetails, error) {
+	return one(f, "StageByID", f.stages, id, query)
+}
+
+// StagesBySeasonID returns the seeded Stage resources of a season.
+func (f *Fake) StagesBySeasonID(ctx context.Context, id int, query *sportmonks.Query) ([]sportmonks.Stage, *sportmonks.ResponseDetails, error) {
+	return all(f, "StagesBySeasonID", query, func() []sportmonks.Stage {
+		return filter(sorted(f.stages), func(s sportmonks.Stage) bool { return s.SeasonID == id })
+	})
+}
+
+// StandingsBySeasonID returns the seeded Standing resources of a season.
+func (f *Fake) StandingsBySeasonID(ctx context.Context, seasonID int, query *sportmonks.Query) ([]sportmonks.Standing, *sportmonks.ResponseDetails, error) {
+	return all(f, "StandingsBySeasonID", query, func() []sportmonks.Standing {
+		return filter(sorted(f.standings), func(s sportmonks.Standing) bool { return s.SeasonID == seasonID })
+	})
+}
+
+// StandingsByRoundID returns the seeded Standing resources of a round.
+func (f *Fake) StandingsByRoundID(ctx context.Context, roundID int, query *sportmonks.Query) ([]sportmonks.Standing, *sportmonks.ResponseDetails, error) {
+	return all(f, "StandingsByRoundID", query, func() []sportmonks.Standing {
+		return filter(sorted(f.standings), func(s sportmonks.Standing) bool { return s.RoundID == roundID })
+	})
+}
+
+// LiveStandingsByLeagueID returns the seeded Standing resources of the current season of a league.
+func (f *Fake) LiveStandingsByLeagueID(ctx context.Context, leagueID int, query *sportmonks.Query) ([]sportmonks.Standing, *sportmonks.ResponseDetails, error) {
+	return all(f, "LiveStandingsByLeagueID", query, func() []sportmonks.Standing {
+		current := 0
+
+		for _, s := range f.seasons {
+			if s.LeagueID == leagueID && s.IsCurrent {
+				current = s.ID
+			}
+		}
+
+		return filter(sorted(f.standings), func(s sportmonks.Standing) bool {
+			return s.LeagueID == leagueID && (current == 0 || s.SeasonID == current)
+		})
+	})
+}
+
+// StandingCorrectionsBySeasonID returns the seeded StandingCorrection resources of a season.
+func (f *Fake) StandingCorrectionsBySeasonID(ctx context.Context, seasonID int, query *sportmonks.Query) ([]sportmonks.StandingCorrection, *sportmonks.ResponseDetails, error) {
+	return all(f, "StandingCorrectionsBySeasonID", query, func() []sportmonks.StandingCorrection {
+		return filter(sorted(f.standingCorrections), func(c sportmonks.StandingCorrection) bool { return c.SeasonID == seasonID })
+	})
+}
+
+// States returns a page of the seeded FixtureState resources.
+func (f *Fake) States(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.FixtureState, *sportmonks.ResponseDetails, error) {
+	return paged(f, "States", query, page, func() []sportmonks.FixtureState {
+		return sorted(f.states)
+	})
+}
+
+// StatesIter returns an iterator over the seeded FixtureState resources.
+func (f *Fake) StatesIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.FixtureState, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.FixtureState, *sportmonks.ResponseDetails, error) {
+		return f.States(ctx, page, query)
+	})
+}
+
+// StateByID returns the seeded FixtureState with the given ID.
+func (f *Fake) StateByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.FixtureState, *sportmonks.ResponseDetails, error) {
+	return one(f, "StateByID", f.states, id, query)
+}
+
+// TeamSquad returns the seeded SquadPlayer resources of a team for a season.
+func (f *Fake) TeamSquad(ctx context.Context, seasonID, teamID int, query *sportmonks.Query) ([]sportmonks.SquadPlayer, *sportmonks.ResponseDetails, error) {
+	return all(f, "TeamSquad", query, func() []sportmonks.SquadPlayer {
+		return f.squads[[2]int{seasonID, teamID}]
+	})
+}
+
+// CurrentSquad returns the seeded current SquadPlayer resources of a team.
+func (f *Fake) CurrentSquad(ctx context.Context, teamID int, query *sportmonks.Query) ([]sportmonks.SquadPlayer, *sportmonks.ResponseDetails, error) {
+	return all(f, "CurrentSquad", query, func() []sportmonks.SquadPlayer {
+		return f.currentSquads[teamID]
+	})
+}
+
+// TeamByID returns the seeded Team with the given ID.
+func (f *Fake) TeamByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Team, *sportmonks.ResponseDetails, error) {
+	return one(f, "TeamByID", f.teams, id, query)
+}
+
+// TeamsBySeasonID returns the seeded Team resources taking part in a season.
+func (f *Fake) TeamsBySeasonID(ctx context.Context, seasonID int, query *sportmonks.Query) ([]sportmonks.Team, *sportmonks.ResponseDetails, error) {
+	return all(f, "TeamsBySeasonID", query, func() []sportmonks.Team {
+		return filter(sorted(f.teams), func(t sportmonks.Team) bool { return slices.Contains(f.seasonTeams[seasonID], t.ID) })
+	})
+}
+
+// TopScorersBySeasonID returns the seeded TopScorer resources of a season.
+func (f *Fake) TopScorersBySeasonID(ctx context.Context, seasonID int, query *sportmonks.Query) ([]sportmonks.TopScorer, *sportmonks.ResponseDetails, error) {
+	return all(f, "TopScorersBySeasonID", query, func() []sportmonks.TopScorer {
+		return filter(sorted(f.topScorers), func(s sportmonks.TopScorer) bool { return s.SeasonID == seasonID })
+	})
+}
+
+// TVStationsByFixtureID returns the seeded TVStation resources broadcasting a fixture.
+func (f *Fake) TVStationsByFixtureID(ctx context.Context, fixtureID int, query *sportmonks.Query) ([]sportmonks.TVStation, *sportmonks.ResponseDetails, error) {
+	return all(f, "TVStationsByFixtureID", query, func() []sportmonks.TVStation {
+		return f.tvStations[fixtureID]
+	})
+}
+
+// Types returns a page of the seeded Type resources.
+func (f *Fake) Types(ctx context.Context, page int, query *sportmonks.Query) ([]sportmonks.Type, *sportmonks.ResponseDetails, error) {
+	return paged(f, "Types", query, page, func() []sportmonks.Type {
+		return sorted(f.types)
+	})
+}
+
+// TypesIter returns an iterator over the seeded Type resources.
+func (f *Fake) TypesIter(ctx context.Context, query *sportmonks.Query) iter.Seq2[sportmonks.Type, error] {
+	return sportmonks.Paginate(ctx, func(ctx context.Context, page int) ([]sportmonks.Type, *sportmonks.ResponseDetails, error) {
+		return f.Types(ctx, page, query)
+	})
+}
+
+// TypeByID returns the seeded Type with the given ID.
+func (f *Fake) TypeByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Type, *sportmonks.ResponseDetails, error) {
+	return one(f, "TypeByID", f.types, id, query)
+}
+
+// TypesByEntity returns the Type resources seeded for each entity using AddEntityTypes.
+func (f *Fake) TypesByEntity(ctx context.Context, query *sportmonks.Query) (map[string][]sportmonks.Type, *sportmonks.ResponseDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors["TypesByEntity"]; err != nil {
+		return nil, nil, err
+	}
+
+	entities := make(map[string][]sportmonks.Type, len(f.entityTypes))
+
+	for entity, ids := range f.entityTypes {
+		entities[entity] = filter(sorted(f.types), func(t sportmonks.Type) bool { return slices.Contains(ids, t.ID) })
+	}
+
+	return entities, details(query, nil), nil
+}
+
+// VenueByID returns the seeded Venue with the given ID.
+func (f *Fake) VenueByID(ctx context.Context, id int, query *sportmonks.Query) (*sportmonks.Venue, *sportmonks.ResponseDetails, error) {
+	return one(f, "VenueByID", f.venues, id, query)
+}
+
+// VenuesBySeasonID returns the seeded Venue resources used within a season.
+func (f *Fake) VenuesBySeasonID(ctx context.Context, id int, query *sportmonks.Query) ([]sportmonks.Venue, *sportmonks.ResponseDetails, error) {
+	return all(f, "VenuesBySeasonID", query, func() []sportmonks.Venue {
+		return filter(sorted(f.venues), func(v sportmonks.Venue) bool { return slices.Contains(f.seasonVenues[id], v.ID) })
+	})
+}
+
+func (f *Fake) now() time.Time {
+	if f.Now != nil {
+		return f.Now()
+	}
+
+	return time.Now()
+}
+
+// fixturesBetween returns fixtures starting between two dates inclusive, limited to fixtures of the given team when
+// teamID is not zero.
+func (f *Fake) fixturesBetween(from, to time.Time, teamID int) []sportmonks.Fixture {
+	start, end := from.Format(sportmonks.DateLayout), to.Format(sportmonks.DateLayout)
+
+	return filter(sorted(f.fixtures), func(fx sportmonks.Fixture) bool {
+		date := fx.StartingAt.Format(sportmonks.DateLayout)
+
+		return date >= start && date <= end && (teamID == 0 || participates(fx, teamID))
+	})
+}
+
+// takeUpdated returns the updated fixtures matching keep, clearing their updated marker.
+func (f *Fake) takeUpdated(updated map[int]bool, keep func(sportmonks.Fixture) bool) []sportmonks.Fixture {
+	var fixtures []sportmonks.Fixture
+
+	for _, fx := range sorted(f.fixtures) {
+		if updated[fx.ID] && keep(fx) {
+			fixtures = append(fixtures, fx)
+		}
+
+		delete(updated, fx.ID)
+	}
+
+	return fixtures
+}
+
+// takeMarked returns the resources with an ID held by marked in ascending ID order, clearing the marked IDs.
+func takeMarked[T any](resources map[int]T, marked map[int]bool) []T {
+	var items []T
+
+	for id, r := range sortedEntries(resources) {
+		if marked[id] {
+			items = append(items, r)
+		}
+	}
+
+	clear(marked)
+
+	return items
+}
+
+func (f *Fake) perPage(query *sportmonks.Query) int {
+	if n, err := strconv.Atoi(query.Values().Get("per_page")); err == nil && n > 0 {
+		return n
+	}
+
+	if f.PerPage > 0 {
+		return f.PerPage
+	}
+
+	return defaultPerPage
+}
+
+func participates(fx sportmonks.Fixture, teamID int) bool {
+	for _, t := range fx.Participants {
+		if t.ID == teamID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matches reports whether name contains the search term, ignoring case.
+func matches(name, term string) bool {
+	return strings.Contains(strings.ToLower(name), strings.ToLower(term))
+}
+
+func inPlay(fx sportmonks.Fixture) bool {
+	return sportmonks.StateID(fx.StateID).IsLive()
+}
+
+func details(query *sportmonks.Query, pagination *sportmonks.Pagination) *sportmonks.ResponseDetails {
+	tz := query.Values().Get("timezone")
+
+	if tz == "" {
+		tz = "UTC"
+	}
+
+	return &sportmonks.ResponseDetails{Pagination: pagination, TimeZone: tz}
+}
+
+// one returns the resource with the given ID, or nil when it has not been seeded as the API returns no data for an
+// unknown ID.
+func one[T any](f *Fake, method string, resources map[int]T, id int, query *sportmonks.Query) (*T, *sportmonks.ResponseDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors[method]; err != nil {
+		return nil, nil, err
+	}
+
+	r, ok := resources[id]
+
+	if !ok {
+		return nil, details(query, nil), nil
+	}
+
+	r = trim(r, parseIncludes(query))
+
+	return &r, details(query, nil), nil
+}
+
+// all returns every resource selected by list.
+func all[T any](f *Fake, method string, query *sportmonks.Query, list func() []T) ([]T, *sportmonks.ResponseDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors[method]; err != nil {
+		return nil, nil, err
+	}
+
+	return trimAll(list(), query), details(query, nil), nil
+}
+
+// paged returns a page of the resources selected by list.
+func paged[T any](f *Fake, method string, query *sportmonks.Query, page int, list func() []T) ([]T, *sportmonks.ResponseDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors[method]; err != nil {
+		return nil, nil, err
+	}
+
+	items, pagination := paginate(list(), page, f.perPage(query))
+
+	return trimAll(items, query), details(query, pagination), nil
+}
+
+func paginate[T any](items []T, page, perPage int) ([]T, *sportmonks.Pagination) {
+	if page < 1 {
+		page = 1
+	}
+
+	start := min((page-1)*perPage, len(items))
+	end := min(start+perPage, len(items))
+
+	return items[start:end], &sportmonks.Pagination{
+		Count:       end - start,
+		PerPage:     perPage,
+		CurrentPage: page,
+		HasMore:     end < len(items),
+	}
+}
+
+func trimAll[T any](items []T, query *sportmonks.Query) []T {
+	inc := parseIncludes(query)
+	trimmed := make([]T, len(items))
+
+	for i, item := range items {
+		trimmed[i] = trim(item, inc)
+	}
+
+	return trimmed
+}
+
+func sorted[T any](resources map[int]T) []T {
+	items := make([]T, 0, len(resources))
+
+	for _, r := range sortedEntries(resources) {
+		items = append(items, r)
+	}
+
+	return items
+}
+
+// sortedEntries returns an iterator over resources in ascending ID order.
+func sortedEntries[T any](resources map[int]T) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for _, id := range slices.Sorted(maps.Keys(resources)) {
+			if !yield(id, resources[id]) {
+				return
+			}
+		}
+	}
+}
+
+func filter[T any](items []T, keep func(T) bool) []T {
+	var kept []T
+
+	for _, item := range items {
+		if keep(item) {
+			kept = append(kept, item)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,456 @@
+package sportmonkstest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func seededFake() *Fake {
+	f := NewFake()
+
+	home := sportmonks.Team{ID: 1, Name: "West Ham United"}
+	away := sportmonks.Team{ID: 2, Name: "Manchester City"}
+	other := sportmonks.Team{ID: 3, Name: "Arsenal"}
+
+	f.AddFixtures(
+		sportmonks.Fixture{
+			ID:           10,
+			StateID:      1,
+			StartingAt:   sportmonks.NewTime(time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC)),
+			Participants: []sportmonks.Team{home, away},
+			Venues:       &sportmonks.Venue{ID: 100, Name: "London Stadium"},
+			Events:       []sportmonks.FixtureEvent{{ID: 1000, TypeID: 14}},
+		},
+		sportmonks.Fixture{
+			ID:           11,
+			StateID:      2,
+			StartingAt:   sportmonks.NewTime(time.Date(2024, 8, 18, 16, 30, 0, 0, time.UTC)),
+			Participants: []sportmonks.Team{away, other},
+		},
+		sportmonks.Fixture{
+			ID:           12,
+			StateID:      5,
+			StartingAt:   sportmonks.NewTime(time.Date(2024, 8, 24, 12, 30, 0, 0, time.UTC)),
+			Participants: []sportmonks.Team{other, home},
+		},
+	)
+
+	f.AddTeams(home, away, other)
+
+	return f
+}
+
+func TestFake_ByID(t *testing.T) {
+	t.Run("returns the seeded resource matching the ID", func(t *testing.T) {
+		f := seededFake()
+
+		team, details, err := f.TeamByID(context.Background(), 2, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, team.ID)
+		assert.Equal(t, "Manchester City", team.Name)
+		assert.Equal(t, "UTC", details.TimeZone)
+	})
+
+	t.Run("returns nil for an unknown ID", func(t *testing.T) {
+		f := seededFake()
+
+		team, details, err := f.TeamByID(context.Background(), 99, nil)
+
+		assert.Nil(t, err)
+		assert.Nil(t, team)
+		assert.NotNil(t, details)
+	})
+
+	t.Run("returns the error set for the method", func(t *testing.T) {
+		f := seededFake()
+
+		f.SetError("TeamByID", &sportmonks.ErrRateLimit{StatusCode: 429})
+
+		_, _, err := f.TeamByID(context.Background(), 2, nil)
+
+		var rateLimit *sportmonks.ErrRateLimit
+
+		assert.True(t, errors.As(err, &rateLimit))
+
+		f.SetError("TeamByID", nil)
+
+		_, _, err = f.TeamByID(context.Background(), 2, nil)
+
+		assert.Nil(t, err)
+	})
+}
+
+func TestFake_Includes(t *testing.T) {
+	t.Run("relations are removed unless included", func(t *testing.T) {
+		f := seededFake()
+
+		fixture, _, err := f.FixtureByID(context.Background(), 10, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, fixture.Participants)
+		assert.Nil(t, fixture.Venues)
+		assert.Nil(t, fixture.Events)
+		assert.Equal(t, "2024-08-17 14:00:00", fixture.StartingAt.String())
+	})
+
+	t.Run("included relations are returned", func(t *testing.T) {
+		f := seededFake()
+
+		query := sportmonks.NewQuery().Include("participants", "venues").IncludeFilter("events", "type_id", "14")
+
+		fixture, _, err := f.FixtureByID(context.Background(), 10, query)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixture.Participants))
+		assert.Equal(t, "London Stadium", fixture.Venues.Name)
+		assert.Equal(t, 1, len(fixture.Events))
+	})
+
+	t.Run("trimming does not modify the seeded resource", func(t *testing.T) {
+		f := seededFake()
+
+		_, _, _ = f.FixtureByID(context.Background(), 10, nil)
+
+		fixture, _, _ := f.FixtureByID(context.Background(), 10, sportmonks.NewQuery().Include("participants"))
+
+		assert.Equal(t, 2, len(fixture.Participants))
+	})
+}
+
+func TestFake_Dates(t *testing.T) {
+	t.Run("fixtures are filtered by date", func(t *testing.T) {
+		f := seededFake()
+
+		fixtures, _, err := f.FixturesByDate(context.Background(), time.Date(2024, 8, 18, 0, 0, 0, 0, time.UTC), nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 11, fixtures[0].ID)
+	})
+
+	t.Run("fixtures are filtered between dates and by team", func(t *testing.T) {
+		f := seededFake()
+
+		from := time.Date(2024, 8, 17, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 8, 24, 0, 0, 0, 0, time.UTC)
+
+		fixtures, _, err := f.FixturesBetween(context.Background(), from, to, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(fixtures))
+
+		fixtures, _, err = f.FixturesBetweenForTeam(context.Background(), from, to, 1, 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixtures))
+		assert.Equal(t, 10, fixtures[0].ID)
+		assert.Equal(t, 12, fixtures[1].ID)
+	})
+
+	t.Run("livescores returns fixtures taking place today", func(t *testing.T) {
+		f := seededFake()
+		f.Now = func() time.Time { return time.Date(2024, 8, 24, 13, 0, 0, 0, time.UTC) }
+
+		fixtures, _, err := f.Livescores(context.Background(), nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 12, fixtures[0].ID)
+	})
+}
+
+func TestFake_Pagination(t *testing.T) {
+	t.Run("resources are returned a page at a time", func(t *testing.T) {
+		f := seededFake()
+		f.PerPage = 2
+
+		fixtures, details, err := f.HeadToHead(context.Background(), 1, 2, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.False(t, details.Pagination.HasMore)
+
+		fixtures, details, err = f.FixturesByID(context.Background(), []int{10, 11, 12}, nil, 2)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 12, fixtures[0].ID)
+		assert.Equal(t, 2, details.Pagination.CurrentPage)
+		assert.Equal(t, 2, details.Pagination.PerPage)
+		assert.False(t, details.Pagination.HasMore)
+	})
+
+	t.Run("the query per page value is used", func(t *testing.T) {
+		f := seededFake()
+
+		fixtures, details, err := f.FixturesByID(context.Background(), []int{10, 11, 12}, sportmonks.NewQuery().PerPage(1), 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.True(t, details.Pagination.HasMore)
+	})
+
+	t.Run("iterators walk every page", func(t *testing.T) {
+		f := seededFake()
+		f.PerPage = 1
+
+		from := time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2024, 8, 31, 0, 0, 0, 0, time.UTC)
+
+		fixtures, err := sportmonks.Collect(f.FixturesBetweenIter(context.Background(), from, to, nil), 0)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(fixtures))
+	})
+}
+
+func TestFake_Livescores(t *testing.T) {
+	t.Run("latest livescores returns in play fixtures updated since the previous call", func(t *testing.T) {
+		f := seededFake()
+
+		fixtures, _, _ := f.InplayLivescores(context.Background(), nil)
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 11, fixtures[0].ID)
+
+		fixtures, _, _ = f.LatestLivescores(context.Background(), nil)
+
+		assert.Equal(t, 1, len(fixtures))
+
+		fixtures, _, _ = f.LatestLivescores(context.Background(), nil)
+
+		assert.Equal(t, 0, len(fixtures))
+
+		f.AddFixtures(sportmonks.Fixture{ID: 11, StateID: 3, StartingAt: sportmonks.NewTime(time.Date(2024, 8, 18, 16, 30, 0, 0, time.UTC))})
+
+		fixtures, _, _ = f.LatestLivescores(context.Background(), nil)
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 3, fixtures[0].StateID)
+	})
+
+	t.Run("drives a live feed", func(t *testing.T) {
+		f := seededFake()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		client := &primingClient{Fake: f, primed: make(chan struct{})}
+
+		events := sportmonks.NewLiveFeed(client, time.Millisecond).Start(ctx)
+
+		<-client.primed
+
+		f.AddFixtures(sportmonks.Fixture{
+			ID:      11,
+			StateID: 3,
+			Events:  []sportmonks.FixtureEvent{{ID: 2000, FixtureID: 11, TypeID: 14}},
+		})
+
+		var received []sportmonks.LiveEventType
+
+		timeout := time.After(time.Second)
+
+		for len(received) < 2 {
+			select {
+			case e := <-events:
+				received = append(received, e.Type)
+			case <-timeout:
+				t.Fatalf("Test failed, timed out waiting for live events, got %v", received)
+			}
+		}
+
+		assert.ElementsMatch(t, []sportmonks.LiveEventType{sportmonks.LiveEventStateChange, sportmonks.LiveEventGoal}, received)
+	})
+}
+
+// primingClient signals once the in play fixtures have been fetched to prime a live feed.
+type primingClient struct {
+	*Fake
+	primed chan struct{}
+	once   sync.Once
+}
+
+func (c *primingClient) InplayLivescores(ctx context.Context, query *sportmonks.Query) ([]sportmonks.Fixture, *sportmonks.ResponseDetails, error) {
+	defer c.once.Do(func() { close(c.primed) })
+	return c.Fake.InplayLivescores(ctx, query)
+}
+
+func TestFake_BookmakersAndMarkets(t *testing.T) {
+	f := NewFake()
+
+	f.AddBookmakers(
+		sportmonks.Bookmaker{ID: 2, Name: "bet365"},
+		sportmonks.Bookmaker{ID: 9, Name: "Betfair"},
+		sportmonks.Bookmaker{ID: 23, Name: "Pinnacle"},
+	)
+	f.AddMarkets(
+		sportmonks.Market{ID: 1, Name: "Fulltime Result"},
+		sportmonks.Market{ID: 80, Name: "Goals Over/Under"},
+	)
+	f.AddPrematchOdds(
+		sportmonks.PrematchOdds{ID: 1, FixtureID: 10, BookmakerID: 23, MarketID: 1},
+		sportmonks.PrematchOdds{ID: 2, FixtureID: 10, BookmakerID: 2, MarketID: 1},
+		sportmonks.PrematchOdds{ID: 3, FixtureID: 11, BookmakerID: 9, MarketID: 1},
+	)
+
+	t.Run("returns the bookmakers offering odds on a fixture", func(t *testing.T) {
+		bookmakers, _, err := f.BookmakersByFixtureID(context.Background(), 10, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []sportmonks.Bookmaker{{ID: 2, Name: "bet365"}, {ID: 23, Name: "Pinnacle"}}, bookmakers)
+	})
+
+	t.Run("searches by name ignoring case", func(t *testing.T) {
+		bookmakers, _, _ := f.BookmakerSearch(context.Background(), "BET", nil)
+
+		assert.Equal(t, 2, len(bookmakers))
+
+		markets, _, _ := f.MarketSearch(context.Background(), "goals", nil)
+
+		assert.Equal(t, []sportmonks.Market{{ID: 80, Name: "Goals Over/Under"}}, markets)
+	})
+
+	t.Run("loads an odds catalog", func(t *testing.T) {
+		catalog := sportmonks.NewOddsCatalog(f)
+
+		odds, _, _ := f.PrematchOddsByFixtureID(context.Background(), 10, nil, 1)
+
+		if err := catalog.EnrichPrematchOdds(context.Background(), odds); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "Pinnacle", odds[0].Bookmaker.Name)
+		assert.Equal(t, "Fulltime Result", odds[1].Market.Name)
+	})
+}
+
+func TestFake_IncludesIgnoreCase(t *testing.T) {
+	f := NewFake()
+
+	f.AddFixtures(sportmonks.Fixture{
+		ID:            10,
+		ExpectedGoals: []sportmonks.ExpectedGoals{{ID: 1, TypeID: 5304, ParticipantID: 1}},
+		Events:        []sportmonks.FixtureEvent{{ID: 1000, TypeID: 14}},
+	})
+
+	fixture, _, err := f.FixtureByID(context.Background(), 10, sportmonks.NewQuery().Include("xGFixture"))
+
+	if err != nil {
+		t.Fatalf("Test failed, expected nil, got %s", err.Error())
+	}
+
+	assert.Equal(t, 1, len(fixture.ExpectedGoals))
+	assert.Nil(t, fixture.Events)
+}
+
+func TestFake_Types(t *testing.T) {
+	f := NewFake()
+
+	goal := sportmonks.Type{ID: 14, Name: "Goal", DeveloperName: "GOAL", ModelType: "event"}
+	goals := sportmonks.Type{ID: 52, Name: "Goals", DeveloperName: "GOALS", ModelType: "statistic"}
+
+	f.AddTypes(goal)
+	f.AddEntityTypes("FixtureStatistic", goals)
+
+	t.Run("returns every seeded type", func(t *testing.T) {
+		types, _, err := f.Types(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, []sportmonks.Type{goal, goals}, types)
+	})
+
+	t.Run("returns the types seeded for each entity", func(t *testing.T) {
+		entities, _, err := f.TypesByEntity(context.Background(), nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, map[string][]sportmonks.Type{"FixtureStatistic": {goals}}, entities)
+	})
+
+	t.Run("resolves types using a registry", func(t *testing.T) {
+		reg := sportmonks.NewTypeRegistry(f)
+
+		if err := reg.Load(context.Background()); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		fixture := sportmonks.Fixture{Events: []sportmonks.FixtureEvent{{ID: 1, TypeID: 14}}}
+		fixture.ResolveTypes(reg)
+
+		assert.Equal(t, "Goal", fixture.Events[0].Type.Name)
+	})
+}
+
+func TestFake_States(t *testing.T) {
+	f := NewFake()
+
+	f.AddStates(
+		sportmonks.FixtureState{ID: 5, State: "FT", Name: "Full Time"},
+		sportmonks.FixtureState{ID: 1, State: "NS", Name: "Not Started"},
+	)
+
+	states, err := sportmonks.Collect(f.StatesIter(context.Background(), nil), 0)
+
+	if err != nil {
+		t.Fatalf("Test failed, expected nil, got %s", err.Error())
+	}
+
+	assert.Equal(t, []sportmonks.FixtureState{{ID: 1, State: "NS", Name: "Not Started"}, {ID: 5, State: "FT", Name: "Full Time"}}, states)
+
+	state, _, err := f.StateByID(context.Background(), 5, nil)
+
+	if err != nil {
+		t.Fatalf("Test failed, expected nil, got %s", err.Error())
+	}
+
+	assert.True(t, state.IsFinished())
+}
@@ -0,0 +1,130 @@
+package sportmonkstest
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+)
+
+// includes is a tree of requested relations, where each relation maps to its nested relations.
+type includes map[string]includes
+
+// parseIncludes builds the tree of relations requested by a query. Include modifiers such as filters and limits are
+// ignored.
+func parseIncludes(query *sportmonks.Query) includes {
+	return parseIncludeParam(query.Values().Get("include"))
+}
+
+// parseIncludeParam builds the tree of relations within an 'include' query parameter, e.g.
+// 'participants.venue;events:minute'. Relation names are lower cased as the API matches them ignoring case.
+func parseIncludeParam(param string) includes {
+	tree := includes{}
+
+	for _, i := range strings.Split(param, ";") {
+		name, _, _ := strings.Cut(i, ":")
+
+		if name == "" {
+			continue
+		}
+
+		node := tree
+
+		for _, part := range strings.Split(strings.ToLower(name), ".") {
+			if node[part] == nil {
+				node[part] = includes{}
+			}
+
+			node = node[part]
+		}
+	}
+
+	return tree
+}
+
+// trim returns a copy of v with every relation that was not requested removed. Relations are the pointer, slice and
+// map fields of a struct holding structs with an 'omitempty' JSON tag.
+func trim[T any](v T, inc includes) T {
+	rv := reflect.ValueOf(&v).Elem()
+	trimValue(rv, inc)
+	return v
+}
+
+func trimValue(v reflect.Value, inc includes) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(v.Elem())
+		trimValue(c.Elem(), inc)
+		v.Set(c)
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(c, v)
+
+		for i := 0; i < c.Len(); i++ {
+			trimValue(c.Index(i), inc)
+		}
+
+		v.Set(c)
+	case reflect.Struct:
+		t := v.Type()
+
+		for i := 0; i < t.NumField(); i++ {
+			name, ok := relationName(t.Field(i))
+
+			if !ok {
+				continue
+			}
+
+			f := v.Field(i)
+			nested, included := inc[name]
+
+			if !included {
+				f.Set(reflect.Zero(f.Type()))
+				continue
+			}
+
+			trimValue(f, nested)
+		}
+	}
+}
+
+func relationName(f reflect.StructField) (string, bool) {
+	if !f.IsExported() {
+		return "", false
+	}
+
+	switch f.Type.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map:
+		if !isStruct(f.Type.Elem()) {
+			return "", false
+		}
+	default:
+		return "", false
+	}
+
+	name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+	// The meta field holds data about the relationship to the parent resource, so is returned alongside it.
+	if name == "" || name == "-" || name == "meta" || !strings.Contains(opts, "omitempty") {
+		return "", false
+	}
+
+	return strings.ToLower(name), true
+}
+
+func isStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	return t.Kind() == reflect.Struct
+}
@@ -0,0 +1,225 @@
+package sportmonkstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// RecorderMode determines whether a Recorder records or replays requests.
+type RecorderMode int
+
+const (
+	// ModeReplay serves responses from the cassette, failing any request not found within it.
+	ModeReplay RecorderMode = iota
+	// ModeRecord sends requests to the API and records each request and response to the cassette.
+	ModeRecord
+)
+
+const redacted = "REDACTED"
+
+// Cassette holds the recorded interactions of a Recorder.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a recorded request and the response received for it.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request held by a Cassette. URL holds the path and query of the request, with the api_token
+// query parameter scrubbed.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+}
+
+// RecordedResponse is a response held by a Cassette. Any occurrence of the API token is scrubbed from the body.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header"`
+	Body       string      `json:"body"`
+}
+
+// ErrUnexpectedRequest is returned by a Recorder in replay mode for a request without a recorded interaction.
+type ErrUnexpectedRequest struct {
+	Method string
+	URL    string
+}
+
+func (e *ErrUnexpectedRequest) Error() string {
+	return fmt.Sprintf("Unexpected request '%s %s' not found in cassette", e.Method, e.URL)
+}
+
+// Recorder is a http.RoundTripper that records requests and responses to a cassette file, then replays them so
+// decoding can be tested against real API responses without making requests to the API.
+//
+// In replay mode each recorded interaction is served once, in the order recorded, to the request with the same method
+// and URL ignoring the api_token query parameter.
+type Recorder struct {
+	// Mode determines whether requests are recorded or replayed.
+	Mode RecorderMode
+	// Path is the location of the cassette file.
+	Path string
+	// Transport sends requests in record mode. Nil means http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette Cassette
+	played   []bool
+}
+
+// NewRecorder creates a new Recorder using the cassette file at path. In replay mode the cassette is loaded from the
+// file, in record mode the file is written by calling Save.
+func NewRecorder(path string, mode RecorderMode) (*Recorder, error) {
+	r := &Recorder{Mode: mode, Path: path}
+
+	if mode == ModeRecord {
+		return r, nil
+	}
+
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(b, &r.cassette); err != nil {
+		return nil, fmt.Errorf("failed to decode cassette '%s': %w", path, err)
+	}
+
+	r.played = make([]bool, len(r.cassette.Interactions))
+
+	return r, nil
+}
+
+// Client returns a http.Client sending requests using the Recorder, for use with HTTPClient.SetHTTPClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.Mode == ModeRecord {
+		return r.record(req)
+	}
+
+	return r.replay(req)
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (r *Recorder) Save() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.Path, append(b, '\n'), 0o644)
+}
+
+// Unplayed returns the recorded interactions that have not been replayed, so a test can assert every recorded
+// request was made.
+func (r *Recorder) Unplayed() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var unplayed []Interaction
+
+	for i, played := range r.played {
+		if !played {
+			unplayed = append(unplayed, r.cassette.Interactions[i])
+		}
+	}
+
+	return unplayed
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	transport := r.Transport
+
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := transport.RoundTrip(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	recorded := string(body)
+
+	if token := req.URL.Query().Get("api_token"); token != "" {
+		recorded = strings.ReplaceAll(recorded, token, redacted)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  RecordedRequest{Method: req.Method, URL: scrubURL(req)},
+		Response: RecordedResponse{StatusCode: resp.StatusCode, Header: resp.Header.Clone(), Body: recorded},
+	})
+
+	return resp, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	u := scrubURL(req)
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.played[i] || interaction.Request.Method != req.Method || interaction.Request.URL != u {
+			continue
+		}
+
+		r.played[i] = true
+
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, &ErrUnexpectedRequest{Method: req.Method, URL: u}
+}
+
+// scrubURL returns the path and query of a request URL without the api_token query parameter, with the remaining
+// parameters sorted so equivalent requests are matched regardless of the host they were sent to.
+func scrubURL(req *http.Request) string {
+	u := url.URL{Path: req.URL.Path}
+	query := req.URL.Query()
+	query.Del("api_token")
+	u.RawQuery = query.Encode()
+	return u.RequestURI()
+}
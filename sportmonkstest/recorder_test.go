@@ -0,0 +1,206 @@
+package sportmonkstest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func replayClient(t *testing.T, path string) (*sportmonks.HTTPClient, *Recorder) {
+	recorder, err := NewRecorder(path, ModeReplay)
+
+	if err != nil {
+		t.Fatalf("Test failed, expected nil, got %s", err.Error())
+	}
+
+	client := sportmonks.NewDefaultHTTPClient("token")
+	client.SetHTTPClient(recorder.Client())
+	client.SetRetryPolicy(nil)
+
+	return client, recorder
+}
+
+func TestRecorder(t *testing.T) {
+	t.Run("records interactions then replays them", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		path := filepath.Join(t.TempDir(), "cassette.json")
+
+		recorder, err := NewRecorder(path, ModeRecord)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		client := server.Client("secret")
+		client.SetHTTPClient(recorder.Client())
+
+		recorded, _, err := client.FixtureByID(context.Background(), 19134492, sportmonks.NewQuery().Include("participants"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		if err := recorder.Save(); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		server.Close()
+
+		replayer, err := NewRecorder(path, ModeReplay)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		client.SetHTTPClient(replayer.Client())
+
+		replayed, _, err := client.FixtureByID(context.Background(), 19134492, sportmonks.NewQuery().Include("participants"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, recorded, replayed)
+		assert.Empty(t, replayer.Unplayed())
+	})
+
+	t.Run("scrubs the api token from the cassette", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"data": {"id": 1}, "pagination": {"next_page": "https://api.sportmonks.com/v3/football/coaches?api_token=` + r.URL.Query().Get("api_token") + `&page=2"}}`))
+		}))
+		defer server.Close()
+
+		path := filepath.Join(t.TempDir(), "cassette.json")
+		recorder, _ := NewRecorder(path, ModeRecord)
+
+		client := sportmonks.NewDefaultHTTPClient("secret-token")
+		client.SetBaseURL(server.URL)
+		client.SetHTTPClient(recorder.Client())
+
+		_, _, err := client.CoachByID(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		if err := recorder.Save(); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		b, _ := os.ReadFile(path)
+
+		assert.False(t, strings.Contains(string(b), "secret-token"))
+		assert.True(t, strings.Contains(string(b), "api_token=REDACTED"))
+	})
+
+	t.Run("replays a recorded response for decoding", func(t *testing.T) {
+		client, recorder := replayClient(t, "testdata/cassettes/season.json")
+
+		season, details, err := client.SeasonByID(context.Background(), 23614, sportmonks.NewQuery().Include("league"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 23614, season.ID)
+		assert.Equal(t, "2024/2025", season.Name)
+		assert.Equal(t, "2024-08-16", season.StartingAt.String())
+		assert.Equal(t, "Premier League", season.League.Name)
+		assert.Equal(t, "Season", details.RateLimit.RequestedEntity)
+		assert.Empty(t, recorder.Unplayed())
+	})
+
+	t.Run("replays a recorded fixture for decoding", func(t *testing.T) {
+		client, recorder := replayClient(t, "testdata/cassettes/fixture.json")
+
+		query := sportmonks.NewQuery().Include("participants", "scores", "state")
+
+		fixture, details, err := client.FixtureByID(context.Background(), 19134492, query)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 19134492, fixture.ID)
+		assert.Equal(t, "West Ham United vs Manchester City", fixture.Name)
+		assert.Equal(t, "2024-09-21T16:30:00Z", fixture.StartingAt.Format(time.RFC3339))
+		assert.Equal(t, 2, len(fixture.Participants))
+		assert.Equal(t, "away", fixture.Participants[1].Meta.Location)
+		assert.Equal(t, 4, len(fixture.Scores))
+		assert.Equal(t, 3, fixture.Scores[3].ScoreData.Goals)
+		assert.Equal(t, "FT", fixture.FixtureState.DeveloperName)
+		assert.True(t, sportmonks.StateID(fixture.StateID).IsFinished())
+		assert.Equal(t, "Fixture", details.RateLimit.RequestedEntity)
+		assert.Empty(t, recorder.Unplayed())
+	})
+
+	t.Run("replays recorded pre-match odds for decoding", func(t *testing.T) {
+		client, recorder := replayClient(t, "testdata/cassettes/prematch_odds.json")
+
+		query := sportmonks.NewQuery().Include("bookmaker").Filter("markets", 1)
+
+		odds, details, err := client.PrematchOddsByFixtureID(context.Background(), 19134492, query, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(odds))
+		assert.Equal(t, "Home", odds[0].Label)
+		assert.Equal(t, "bet365", odds[0].Bookmaker.Name)
+		assert.Nil(t, odds[0].Total)
+
+		price, err := odds[2].DecimalOdds()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1.57, price)
+
+		fraction, err := odds[0].FractionalOdds()
+
+		assert.Nil(t, err)
+		assert.Equal(t, 5.5, fraction.Decimal())
+		assert.Equal(t, "2024-09-21 16:29:12", odds[0].LatestBookmakerUpdate.Format(time.DateTime))
+		assert.False(t, details.Pagination.HasMore)
+		assert.Equal(t, "Odd", details.RateLimit.RequestedEntity)
+		assert.Empty(t, recorder.Unplayed())
+	})
+
+	t.Run("fails a request not found in the cassette", func(t *testing.T) {
+		client, recorder := replayClient(t, "testdata/cassettes/season.json")
+
+		_, _, err := client.SeasonByID(context.Background(), 23614, nil)
+
+		var unexpected *ErrUnexpectedRequest
+
+		assert.True(t, errors.As(err, &unexpected))
+		assert.Equal(t, "/v3/football/seasons/23614?deleted=1", unexpected.URL)
+		assert.Equal(t, 1, len(recorder.Unplayed()))
+	})
+
+	t.Run("each interaction is replayed once", func(t *testing.T) {
+		client, _ := replayClient(t, "testdata/cassettes/season.json")
+
+		query := sportmonks.NewQuery().Include("league")
+
+		_, _, err := client.SeasonByID(context.Background(), 23614, query)
+
+		assert.Nil(t, err)
+
+		_, _, err = client.SeasonByID(context.Background(), 23614, query)
+
+		var unexpected *ErrUnexpectedRequest
+
+		assert.True(t, errors.As(err, &unexpected))
+	})
+}
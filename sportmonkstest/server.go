@@ -0,0 +1,338 @@
+package sportmonkstest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+)
+
+// Server is a stand-in for the API serving responses from a fixture directory, for running end-to-end tests offline.
+//
+// The response for a request path is read from the JSON file at the same path within the directory, e.g. the file
+// 'football/fixtures/10.json' is served for '/football/fixtures/10' and 'core/countries.json' for '/core/countries'.
+// A file holds either a full response body or only its 'data' value. Responses are completed with the details
+// returned by the API, list data is served a page at a time and relations not requested using the 'include' query
+// parameter are removed. A request for a path without a file receives a 404 response.
+type Server struct {
+	*httptest.Server
+	// Token, when not empty, is the only API token accepted. Requests made with any other token receive a 401 response.
+	Token string
+	// PerPage is the number of resources served per page when the request does not set one. Zero means 25.
+	PerPage int
+
+	dir         string
+	mu          sync.Mutex
+	latency     time.Duration
+	rateLimited int
+	resetsIn    int
+	requests    []*http.Request
+}
+
+// NewServer starts and returns a new Server serving responses from the fixture directory dir. The caller should call
+// Close when finished, to shut it down.
+func NewServer(dir string) *Server {
+	s := &Server{dir: dir}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// Client returns a HTTPClient making requests to the Server using the given API token. Client side rate limiting is
+// disabled.
+func (s *Server) Client(token string) *sportmonks.HTTPClient {
+	client := sportmonks.NewDefaultHTTPClient(token)
+	client.SetHTTPClient(s.Server.Client())
+	client.SetBaseURL(s.URL)
+	client.SetRateLimiter(nil)
+	return client
+}
+
+// SetLatency delays every subsequent response by d.
+func (s *Server) SetLatency(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.latency = d
+}
+
+// RateLimit causes the next n requests to receive a 429 response, reporting that the rate limit resets in the given
+// number of seconds.
+func (s *Server) RateLimit(n, resetsInSeconds int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rateLimited = n
+	s.resetsIn = resetsInSeconds
+}
+
+// Requests returns every request received by the Server, in the order they were received.
+func (s *Server) Requests() []*http.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]*http.Request(nil), s.requests...)
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests = append(s.requests, r)
+	latency := s.latency
+	limited := s.rateLimited > 0
+
+	if limited {
+		s.rateLimited--
+	}
+
+	resetsIn := s.resetsIn
+	s.mu.Unlock()
+
+	if latency > 0 {
+		select {
+		case <-time.After(latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+
+	query := r.URL.Query()
+	entity := requestedEntity(r.URL.Path)
+
+	if s.Token != "" && query.Get("api_token") != s.Token {
+		writeJSON(w, http.StatusUnauthorized, map[string]interface{}{
+			"message": "Unauthenticated.",
+		})
+		return
+	}
+
+	if limited {
+		w.Header().Set("Retry-After", strconv.Itoa(resetsIn))
+		writeJSON(w, http.StatusTooManyRequests, map[string]interface{}{
+			"message":    "You have reached your rate limit",
+			"link":       "https://docs.sportmonks.com/football/api/response-codes/other-exceptions",
+			"reset_code": "sportmonkstest",
+			"rate_limit": sportmonks.RateLimit{ResetsInSeconds: resetsIn, RequestedEntity: entity},
+		})
+		return
+	}
+
+	body, err := s.load(r.URL.Path)
+
+	if err != nil {
+		writeJSON(w, http.StatusNotFound, map[string]interface{}{
+			"message": "No result(s) found matching your request. Either the query did not return any results or you don't have access to it via your current subscription.",
+		})
+		return
+	}
+
+	var data interface{}
+
+	if err := json.Unmarshal(body["data"], &data); err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{"message": err.Error()})
+		return
+	}
+
+	data = trimJSON(data, parseIncludeParam(query.Get("include")))
+
+	response := map[string]interface{}{
+		"data":         data,
+		"subscription": rawOr(body["subscription"], []sportmonks.Subscription{{Plans: []sportmonks.Plan{}}}),
+		"rate_limit":   rawOr(body["rate_limit"], sportmonks.RateLimit{ResetsInSeconds: 3600, Remaining: 2999, RequestedEntity: entity}),
+		"timezone":     rawOr(body["timezone"], timezone(query)),
+	}
+
+	if items, ok := data.([]interface{}); ok {
+		page, pagination := s.paginate(r.URL, len(items))
+		start := min((page-1)*pagination.PerPage, len(items))
+		response["data"] = items[start : start+pagination.Count]
+		response["pagination"] = pagination
+	}
+
+	writeJSON(w, http.StatusOK, response)
+}
+
+// load reads the fixture file for a request path, wrapping a file holding only data in a response body.
+func (s *Server) load(path string) (map[string]json.RawMessage, error) {
+	file := filepath.Join(s.dir, filepath.FromSlash(strings.Trim(path, "/"))+".json")
+
+	b, err := os.ReadFile(file)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var body map[string]json.RawMessage
+
+	if json.Unmarshal(b, &body) == nil {
+		if _, ok := body["data"]; ok {
+			return body, nil
+		}
+	}
+
+	return map[string]json.RawMessage{"data": b}, nil
+}
+
+func (s *Server) paginate(u *url.URL, total int) (int, sportmonks.Pagination) {
+	query := u.Query()
+
+	perPage := s.PerPage
+
+	if n, err := strconv.Atoi(query.Get("per_page")); err == nil && n > 0 {
+		perPage = n
+	}
+
+	if perPage <= 0 {
+		perPage = defaultPerPage
+	}
+
+	page, _ := strconv.Atoi(query.Get("page"))
+
+	if page < 1 {
+		page = 1
+	}
+
+	start := min((page-1)*perPage, total)
+	end := min(start+perPage, total)
+
+	pagination := sportmonks.Pagination{
+		Count:       end - start,
+		PerPage:     perPage,
+		CurrentPage: page,
+		HasMore:     end < total,
+	}
+
+	if pagination.HasMore {
+		query.Set("page", strconv.Itoa(page+1))
+		query.Del("api_token")
+		next := s.URL + u.Path + "?" + query.Encode()
+		pagination.NextPage = &next
+	}
+
+	return page, pagination
+}
+
+// relations holds the lower cased JSON names of every relation of the resources returned by the API.
+var relations = relationNames(
+	sportmonks.Bookmaker{}, sportmonks.Coach{}, sportmonks.Commentary{}, sportmonks.Continent{}, sportmonks.Country{},
+	sportmonks.ExpectedGoals{}, sportmonks.Fixture{}, sportmonks.FixtureState{}, sportmonks.League{},
+	sportmonks.Market{}, sportmonks.PrematchOdds{}, sportmonks.InplayOdds{}, sportmonks.Probability{},
+	sportmonks.ValueBet{}, sportmonks.Player{}, sportmonks.Round{}, sportmonks.Season{},
+	sportmonks.Stage{}, sportmonks.Standing{}, sportmonks.StandingCorrection{}, sportmonks.SquadPlayer{},
+	sportmonks.Team{}, sportmonks.TopScorer{}, sportmonks.TVStation{}, sportmonks.Type{}, sportmonks.Venue{},
+)
+
+func relationNames(resources ...interface{}) map[string]bool {
+	names := map[string]bool{}
+	seen := map[reflect.Type]bool{}
+
+	var walk func(t reflect.Type)
+
+	walk = func(t reflect.Type) {
+		for t.Kind() == reflect.Ptr || t.Kind() == reflect.Slice || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+
+		if t.Kind() != reflect.Struct || seen[t] {
+			return
+		}
+
+		seen[t] = true
+
+		for i := 0; i < t.NumField(); i++ {
+			if name, ok := relationName(t.Field(i)); ok {
+				names[name] = true
+			}
+
+			walk(t.Field(i).Type)
+		}
+	}
+
+	for _, r := range resources {
+		walk(reflect.TypeOf(r))
+	}
+
+	return names
+}
+
+// trimJSON removes the relations that were not requested from decoded JSON data.
+func trimJSON(v interface{}, inc includes) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		for i := range v {
+			v[i] = trimJSON(v[i], inc)
+		}
+	case map[string]interface{}:
+		for name, value := range v {
+			switch value.(type) {
+			case map[string]interface{}, []interface{}:
+			default:
+				continue
+			}
+
+			nested, included := inc[strings.ToLower(name)]
+
+			if !included && relations[strings.ToLower(name)] {
+				delete(v, name)
+				continue
+			}
+
+			v[name] = trimJSON(value, nested)
+		}
+	}
+
+	return v
+}
+
+// requestedEntity names the entity of a request path in the form used by the API rate limit, e.g. 'Fixture' for
+// '/football/fixtures/10'.
+func requestedEntity(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	if len(parts) < 2 {
+		return ""
+	}
+
+	var name string
+
+	for _, word := range strings.Split(parts[1], "-") {
+		if word != "" {
+			name += strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+
+	if strings.HasSuffix(name, "ies") {
+		return strings.TrimSuffix(name, "ies") + "y"
+	}
+
+	return strings.TrimSuffix(name, "s")
+}
+
+func timezone(query url.Values) string {
+	if tz := query.Get("timezone"); tz != "" {
+		return tz
+	}
+
+	return "UTC"
+}
+
+func rawOr(raw json.RawMessage, fallback interface{}) interface{} {
+	if raw != nil {
+		return raw
+	}
+
+	return fallback
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
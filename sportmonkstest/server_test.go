@@ -0,0 +1,221 @@
+package sportmonkstest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/srodrichu/statistico-sportmonks-go-client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServer(t *testing.T) {
+	t.Run("serves the fixture file for the request path", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		fixture, details, err := server.Client("token").FixtureByID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 19134492, fixture.ID)
+		assert.Equal(t, "West Ham United vs Manchester City", fixture.Name)
+		assert.Equal(t, "Europe/London", details.TimeZone)
+		assert.Equal(t, "Fixture", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("serves a fixture file holding only data", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		countries, details, err := server.Client("token").Countries(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(countries))
+		assert.Equal(t, []string{"IRL"}, countries[0].Borders)
+		assert.Equal(t, "UTC", details.TimeZone)
+		assert.Equal(t, 1, details.Pagination.Count)
+	})
+
+	t.Run("relations are removed unless included", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		client := server.Client("token")
+
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, fixture.Participants)
+		assert.Nil(t, fixture.Scores)
+		assert.Nil(t, fixture.Venues)
+
+		fixture, _, err = client.FixtureByID(context.Background(), 19134492, sportmonks.NewQuery().Include("participants", "scores"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(fixture.Participants))
+		assert.Equal(t, "home", fixture.Participants[0].Meta.Location)
+		assert.Equal(t, 3, fixture.Scores[1].ScoreData.Goals)
+		assert.Nil(t, fixture.Venues)
+	})
+
+	t.Run("state and expected goals relations are removed unless included", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		client := server.Client("token")
+
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, fixture.FixtureState)
+		assert.Nil(t, fixture.ExpectedGoals)
+
+		fixture, _, err = client.FixtureByID(context.Background(), 19134492, sportmonks.NewQuery().Include("state", "xGFixture"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "FT", fixture.FixtureState.DeveloperName)
+		assert.Equal(t, 1, len(fixture.ExpectedGoals))
+	})
+
+	t.Run("bookmaker and market relations are removed unless included", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		client := server.Client("token")
+
+		odds, _, err := client.PrematchOddsByFixtureID(context.Background(), 19134492, nil, 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Nil(t, odds[0].Bookmaker)
+		assert.Nil(t, odds[0].Market)
+
+		odds, _, err = client.PrematchOddsByFixtureID(context.Background(), 19134492, sportmonks.NewQuery().Include("bookmaker", "market"), 1)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, "bet365", odds[0].Bookmaker.Name)
+		assert.Equal(t, "Fulltime Result", odds[0].Market.Name)
+	})
+
+	t.Run("list data is paginated", func(t *testing.T) {
+		server := NewServer("testdata")
+		server.PerPage = 2
+		defer server.Close()
+
+		client := server.Client("token")
+		date := time.Date(2024, 8, 17, 0, 0, 0, 0, time.UTC)
+
+		fixtures, details, err := client.FixturesByDate(context.Background(), date, nil, 2)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 1, len(fixtures))
+		assert.Equal(t, 19134493, fixtures[0].ID)
+		assert.Equal(t, 2, details.Pagination.CurrentPage)
+		assert.False(t, details.Pagination.HasMore)
+
+		all, err := sportmonks.Collect(client.FixturesByDateIter(context.Background(), date, nil), 0)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 3, len(all))
+		assert.Equal(t, 3, len(server.Requests()))
+	})
+
+	t.Run("returns not found for a path without a fixture file", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		_, _, err := server.Client("token").FixtureByID(context.Background(), 1, nil)
+
+		assert.True(t, errors.Is(err, &sportmonks.ErrNotFound{}))
+	})
+
+	t.Run("rejects an unknown token", func(t *testing.T) {
+		server := NewServer("testdata")
+		server.Token = "token"
+		defer server.Close()
+
+		_, _, err := server.Client("other").FixtureByID(context.Background(), 19134492, nil)
+
+		assert.True(t, errors.Is(err, &sportmonks.ErrUnauthorized{}))
+	})
+
+	t.Run("injected rate limits are retried by the client", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		server.RateLimit(1, 0)
+
+		fixture, _, err := server.Client("token").FixtureByID(context.Background(), 19134492, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 19134492, fixture.ID)
+		assert.Equal(t, 2, len(server.Requests()))
+	})
+
+	t.Run("injected rate limits are returned once retries are exhausted", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		server.RateLimit(3, 0)
+
+		client := server.Client("token")
+		client.SetRetryPolicy(nil)
+
+		_, _, err := client.FixtureByID(context.Background(), 19134492, nil)
+
+		var rateLimit *sportmonks.ErrRateLimit
+
+		assert.True(t, errors.As(err, &rateLimit))
+		assert.Equal(t, "Fixture", rateLimit.RateLimit.RequestedEntity)
+	})
+
+	t.Run("injected latency delays responses", func(t *testing.T) {
+		server := NewServer("testdata")
+		defer server.Close()
+
+		server.SetLatency(100 * time.Millisecond)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		client := server.Client("token")
+		client.SetRetryPolicy(nil)
+
+		_, _, err := client.FixtureByID(ctx, 19134492, nil)
+
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+}
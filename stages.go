@@ -3,8 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 )
 
 // Stage provides a struct representation of a Stage resource.
@@ -24,46 +22,20 @@ type Stage struct {
 	TieBreakerRuleID   *int   `json:"tie_breaker_rule_id"` // Using pointer to handle null value
 }
 
-// StageByID fetches a Stage resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) StageByID(ctx context.Context, id int, includes []string) (*Stage, *Meta, error) {
+// StageByID fetches a Stage resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) StageByID(ctx context.Context, id int, query *Query) (*Stage, *ResponseDetails, error) {
 	path := fmt.Sprintf(stagesURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	values := query.Values()
 
-	response := struct {
-		Data *Stage `json:"data"`
-		Meta *Meta  `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getOne[Stage](ctx, c, path, values)
 }
 
-// StagesBySeasonID fetches a Stage resources by a season ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) StagesBySeasonID(ctx context.Context, id int, includes []string) ([]Stage, *Meta, error) {
+// StagesBySeasonID fetches a Stage resources by a season ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) StagesBySeasonID(ctx context.Context, id int, query *Query) ([]Stage, *ResponseDetails, error) {
 	path := fmt.Sprintf(stagesSeasonURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data []Stage `json:"data"`
-		Meta *Meta   `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, response.Meta, err
+	return getMany[Stage](ctx, c, path, values)
 }
@@ -84,13 +84,13 @@ var stagesSeasonIncludesResponse = `{
 
 func TestStageByID(t *testing.T) {
 	t.Run("returns a Stage struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/stages/10?api_token=api-key&include="
+		url := defaultBaseURL + "/football/stages/10?api_token=api-key"
 
 		server := mockResponseServer(t, stageResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		stage, _, err := client.StageByID(context.Background(), 10, []string{})
+		stage, _, err := client.StageByID(context.Background(), 10, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -106,7 +106,7 @@ func TestStageByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		stage, _, err := client.StageByID(context.Background(), 10, []string{"season", "league", "fixtures", "results"})
+		stage, _, err := client.StageByID(context.Background(), 10, NewQuery().Include("season", "league", "fixtures", "results"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -116,13 +116,13 @@ func TestStageByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/stages/10?api_token=api-key&include="
+		url := defaultBaseURL + "/football/stages/10?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		stage, _, err := client.StageByID(context.Background(), 10, []string{})
+		stage, _, err := client.StageByID(context.Background(), 10, nil)
 
 		if stage != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", stage)
@@ -134,13 +134,13 @@ func TestStageByID(t *testing.T) {
 
 func TestStagesBySeasonID(t *testing.T) {
 	t.Run("returns a slice of Stage struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/stages/seasons/10?api_token=api-key&include="
+		url := defaultBaseURL + "/football/stages/seasons/10?api_token=api-key"
 
 		server := mockResponseServer(t, stagesSeasonResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		stages, _, err := client.StagesBySeasonID(context.Background(), 10, []string{})
+		stages, _, err := client.StagesBySeasonID(context.Background(), 10, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -159,7 +159,7 @@ func TestStagesBySeasonID(t *testing.T) {
 		stages, _, err := client.StagesBySeasonID(
 			context.Background(),
 			10,
-			[]string{"season", "league", "fixtures", "results"},
+			NewQuery().Include("season", "league", "fixtures", "results"),
 		)
 
 		if err != nil {
@@ -170,13 +170,13 @@ func TestStagesBySeasonID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/stages/seasons/10?api_token=api-key&include="
+		url := defaultBaseURL + "/football/stages/seasons/10?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		stages, _, err := client.StagesBySeasonID(context.Background(), 10, []string{})
+		stages, _, err := client.StagesBySeasonID(context.Background(), 10, nil)
 
 		if stages != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", stages)
@@ -0,0 +1,118 @@
+package sportmonks
+
+import (
+	"context"
+	"fmt"
+)
+
+// Standing provides a struct representation of a team position within a league table.
+type Standing struct {
+	ID             int              `json:"id"`
+	ParticipantID  int              `json:"participant_id"`
+	SportID        int              `json:"sport_id"`
+	LeagueID       int              `json:"league_id"`
+	SeasonID       int              `json:"season_id"`
+	StageID        int              `json:"stage_id"`
+	GroupID        *int             `json:"group_id"`
+	RoundID        int              `json:"round_id"`
+	StandingRuleID *int             `json:"standing_rule_id"`
+	Position       int              `json:"position"`
+	Result         *string          `json:"result"`
+	Points         int              `json:"points"`
+	Participant    *Team            `json:"participant,omitempty"`
+	Details        []StandingDetail `json:"details,omitempty"`
+	Rule           *StandingRule    `json:"rule,omitempty"`
+	Group          *Group           `json:"group,omitempty"`
+	Stage          *Stage           `json:"stage,omitempty"`
+	Form           []StandingForm   `json:"form,omitempty"`
+}
+
+// StandingDetail provides a single statistic such as games played or goals scored making up a Standing.
+type StandingDetail struct {
+	ID           int           `json:"id"`
+	StandingType string        `json:"standing_type"`
+	StandingID   int           `json:"standing_id"`
+	TypeID       int           `json:"type_id"`
+	Value        int           `json:"value"`
+	Type         *StandingType `json:"type,omitempty"`
+}
+
+// StandingRule provides the rule applied to a table position, for example promotion or relegation.
+type StandingRule struct {
+	ID        int           `json:"id"`
+	ModelType string        `json:"model_type"`
+	ModelID   int           `json:"model_id"`
+	TypeID    int           `json:"type_id"`
+	Position  int           `json:"position"`
+	Type      *StandingType `json:"type,omitempty"`
+}
+
+// StandingForm provides the result of a recent fixture for the team of a Standing.
+type StandingForm struct {
+	ID           int    `json:"id"`
+	StandingType string `json:"standing_type"`
+	StandingID   int    `json:"standing_id"`
+	FixtureID    int    `json:"fixture_id"`
+	Form         string `json:"form"`
+	SortOrder    int    `json:"sort_order"`
+}
+
+// StandingType describes the type of a StandingDetail or StandingRule.
+type StandingType struct {
+	ID            int     `json:"id"`
+	Name          string  `json:"name"`
+	Code          string  `json:"code"`
+	DeveloperName string  `json:"developer_name"`
+	ModelType     string  `json:"model_type"`
+	StatGroup     *string `json:"stat_group"`
+}
+
+// StandingCorrection provides a points correction applied to a team within a season, for example a points deduction.
+type StandingCorrection struct {
+	ID              int    `json:"id"`
+	SeasonID        int    `json:"season_id"`
+	StageID         int    `json:"stage_id"`
+	GroupID         *int   `json:"group_id"`
+	TypeID          int    `json:"type_id"`
+	ParticipantID   int    `json:"participant_id"`
+	ParticipantType string `json:"participant_type"`
+	Value           int    `json:"value"`
+	CalcType        string `json:"calc_type"`
+	Active          bool   `json:"active"`
+}
+
+// StandingsBySeasonID fetches Standing resources for all stages and groups of a season. Use the query to enrich and
+// filter the response data.
+func (c *HTTPClient) StandingsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]Standing, *ResponseDetails, error) {
+	path := fmt.Sprintf(standingsSeasonURI+"/%d", seasonID)
+
+	return multipleStandingResponse(ctx, c, path, query)
+}
+
+// StandingsByRoundID fetches Standing resources as they were after a given round. Use the query to enrich and filter
+// the response data.
+func (c *HTTPClient) StandingsByRoundID(ctx context.Context, roundID int, query *Query) ([]Standing, *ResponseDetails, error) {
+	path := fmt.Sprintf(standingsRoundURI+"/%d", roundID)
+
+	return multipleStandingResponse(ctx, c, path, query)
+}
+
+// LiveStandingsByLeagueID fetches Standing resources for the current season of a league, updated with the results of
+// fixtures in play. Use the query to enrich and filter the response data.
+func (c *HTTPClient) LiveStandingsByLeagueID(ctx context.Context, leagueID int, query *Query) ([]Standing, *ResponseDetails, error) {
+	path := fmt.Sprintf(standingsLiveLeagueURI+"/%d", leagueID)
+
+	return multipleStandingResponse(ctx, c, path, query)
+}
+
+// StandingCorrectionsBySeasonID fetches StandingCorrection resources applied within a season. Use the query to enrich
+// and filter the response data.
+func (c *HTTPClient) StandingCorrectionsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]StandingCorrection, *ResponseDetails, error) {
+	path := fmt.Sprintf(standingCorrectionsSeasonURI+"/%d", seasonID)
+
+	return getMany[StandingCorrection](ctx, c, path, query.Values())
+}
+
+func multipleStandingResponse(ctx context.Context, client *HTTPClient, path string, query *Query) ([]Standing, *ResponseDetails, error) {
+	return getMany[Standing](ctx, client, path, query.Values())
+}
@@ -0,0 +1,393 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var standingsResponse = `{
+	"data": [
+		{
+			"id": 2611,
+			"participant_id": 1,
+			"sport_id": 1,
+			"league_id": 8,
+			"season_id": 19734,
+			"stage_id": 77457866,
+			"group_id": null,
+			"round_id": 274719,
+			"standing_rule_id": 13224,
+			"position": 1,
+			"result": "up",
+			"points": 78
+		}
+	],
+	"subscription": [
+		{
+			"meta": {
+				"trial_ends_at": null,
+				"ends_at": "2024-10-26 12:06:34",
+				"current_timestamp": 1728372666
+			},
+			"plans": [
+				{
+					"plan": "Joe Sweeny Custom Plan",
+					"sport": "Football",
+					"category": "Advanced"
+				}
+			],
+			"add_ons": [],
+			"widgets": []
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Standing"
+	},
+	"timezone": "UTC"
+}`
+
+var standingsIncludesResponse = `{
+	"data": [
+		{
+			"id": 2611,
+			"participant_id": 1,
+			"sport_id": 1,
+			"league_id": 8,
+			"season_id": 19734,
+			"stage_id": 77457866,
+			"group_id": null,
+			"round_id": 274719,
+			"standing_rule_id": 13224,
+			"position": 1,
+			"result": "up",
+			"points": 78,
+			"participant": {
+				"id": 1,
+				"sport_id": 1,
+				"country_id": 462,
+				"venue_id": 214,
+				"gender": "male",
+				"name": "West Ham United",
+				"short_code": "WHU",
+				"image_path": "https://cdn.sportmonks.com/images/soccer/teams/1/1.png",
+				"founded": 1895,
+				"type": "domestic",
+				"placeholder": false,
+				"last_played_at": "2024-09-21 11:30:00"
+			},
+			"details": [
+				{
+					"id": 1253002,
+					"standing_type": "standing",
+					"standing_id": 2611,
+					"type_id": 129,
+					"value": 38,
+					"type": {
+						"id": 129,
+						"name": "Overall Matches Played",
+						"code": "overall-matches-played",
+						"developer_name": "OVERALL_MATCHES",
+						"model_type": "standings",
+						"stat_group": null
+					}
+				}
+			],
+			"rule": {
+				"id": 13224,
+				"model_type": "standing",
+				"model_id": 2611,
+				"type_id": 180,
+				"position": 1,
+				"type": {
+					"id": 180,
+					"name": "Promotion",
+					"code": "promotion",
+					"developer_name": "PROMOTION",
+					"model_type": "standing_rule",
+					"stat_group": null
+				}
+			},
+			"form": [
+				{
+					"id": 4431,
+					"standing_type": "standing",
+					"standing_id": 2611,
+					"fixture_id": 18535517,
+					"form": "W",
+					"sort_order": 38
+				}
+			]
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Standing"
+	},
+	"timezone": "UTC"
+}`
+
+var standingCorrectionsResponse = `{
+	"data": [
+		{
+			"id": 1,
+			"season_id": 19734,
+			"stage_id": 77457866,
+			"group_id": null,
+			"type_id": 1552,
+			"participant_id": 1,
+			"participant_type": "team",
+			"value": 10,
+			"calc_type": "-",
+			"active": true
+		}
+	],
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Standing"
+	},
+	"timezone": "UTC"
+}`
+
+func TestStandingsBySeasonID(t *testing.T) {
+	t.Run("returns a slice of Standing struct", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/seasons/19734?api_token=api-key"
+
+		server := mockResponseServer(t, standingsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.StandingsBySeasonID(context.Background(), 19734, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, &standings[0])
+	})
+
+	t.Run("returns a slice of Standing struct with includes data", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/seasons/19734?api_token=api-key&include=participant%3Bdetails.type%3Brule.type%3Bform"
+
+		server := mockResponseServer(t, standingsIncludesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		query := NewQuery().Include("participant").NestedInclude("details", "type").NestedInclude("rule", "type").Include("form")
+
+		standings, _, err := client.StandingsBySeasonID(context.Background(), 19734, query)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, &standings[0])
+		assertTeam(t, standings[0].Participant)
+		assertStandingIncludes(t, &standings[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/seasons/19734?api_token=api-key"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.StandingsBySeasonID(context.Background(), 19734, nil)
+
+		if standings != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", standings)
+		}
+
+		assertError(t, err)
+	})
+
+	t.Run("can handle response details", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/seasons/19734?api_token=api-key"
+
+		server := mockResponseServer(t, standingsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		_, details, _ := client.StandingsBySeasonID(context.Background(), 19734, nil)
+
+		assertResponseDetails(t, details, "Standing")
+	})
+}
+
+func TestStandingsByRoundID(t *testing.T) {
+	t.Run("returns a slice of Standing struct", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/rounds/274719?api_token=api-key"
+
+		server := mockResponseServer(t, standingsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.StandingsByRoundID(context.Background(), 274719, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, &standings[0])
+	})
+
+	t.Run("returns a slice of Standing struct with includes data", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/rounds/274719?api_token=api-key&include=participant%3Bdetails.type%3Brule.type%3Bform"
+
+		server := mockResponseServer(t, standingsIncludesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		query := NewQuery().Include("participant", "details.type", "rule.type", "form")
+
+		standings, _, err := client.StandingsByRoundID(context.Background(), 274719, query)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, &standings[0])
+		assertStandingIncludes(t, &standings[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/rounds/274719?api_token=api-key"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.StandingsByRoundID(context.Background(), 274719, nil)
+
+		if standings != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", standings)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestLiveStandingsByLeagueID(t *testing.T) {
+	t.Run("returns a slice of Standing struct", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/live/leagues/8?api_token=api-key"
+
+		server := mockResponseServer(t, standingsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.LiveStandingsByLeagueID(context.Background(), 8, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertStanding(t, &standings[0])
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/live/leagues/8?api_token=api-key"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		standings, _, err := client.LiveStandingsByLeagueID(context.Background(), 8, nil)
+
+		if standings != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", standings)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestStandingCorrectionsBySeasonID(t *testing.T) {
+	t.Run("returns a slice of StandingCorrection struct", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/corrections/seasons/19734?api_token=api-key"
+
+		server := mockResponseServer(t, standingCorrectionsResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		corrections, _, err := client.StandingCorrectionsBySeasonID(context.Background(), 19734, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		correction := corrections[0]
+
+		assert.Equal(t, 1, correction.ID)
+		assert.Equal(t, 19734, correction.SeasonID)
+		assert.Equal(t, 77457866, correction.StageID)
+		assert.Nil(t, correction.GroupID)
+		assert.Equal(t, 1552, correction.TypeID)
+		assert.Equal(t, 1, correction.ParticipantID)
+		assert.Equal(t, "team", correction.ParticipantType)
+		assert.Equal(t, 10, correction.Value)
+		assert.Equal(t, "-", correction.CalcType)
+		assert.Equal(t, true, correction.Active)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		url := defaultBaseURL + "/football/standings/corrections/seasons/19734?api_token=api-key"
+
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		corrections, _, err := client.StandingCorrectionsBySeasonID(context.Background(), 19734, nil)
+
+		if corrections != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", corrections)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func assertStanding(t *testing.T, standing *Standing) {
+	ruleID := 13224
+	result := "up"
+
+	assert.Equal(t, 2611, standing.ID)
+	assert.Equal(t, 1, standing.ParticipantID)
+	assert.Equal(t, 1, standing.SportID)
+	assert.Equal(t, 8, standing.LeagueID)
+	assert.Equal(t, 19734, standing.SeasonID)
+	assert.Equal(t, 77457866, standing.StageID)
+	assert.Nil(t, standing.GroupID)
+	assert.Equal(t, 274719, standing.RoundID)
+	assert.Equal(t, &ruleID, standing.StandingRuleID)
+	assert.Equal(t, 1, standing.Position)
+	assert.Equal(t, &result, standing.Result)
+	assert.Equal(t, 78, standing.Points)
+}
+
+func assertStandingIncludes(t *testing.T, standing *Standing) {
+	detail := standing.Details[0]
+
+	assert.Equal(t, 1253002, detail.ID)
+	assert.Equal(t, "standing", detail.StandingType)
+	assert.Equal(t, 2611, detail.StandingID)
+	assert.Equal(t, 129, detail.TypeID)
+	assert.Equal(t, 38, detail.Value)
+	assert.Equal(t, "OVERALL_MATCHES", detail.Type.DeveloperName)
+
+	assert.Equal(t, 13224, standing.Rule.ID)
+	assert.Equal(t, "standing", standing.Rule.ModelType)
+	assert.Equal(t, 2611, standing.Rule.ModelID)
+	assert.Equal(t, 180, standing.Rule.TypeID)
+	assert.Equal(t, 1, standing.Rule.Position)
+	assert.Equal(t, "Promotion", standing.Rule.Type.Name)
+
+	assert.Equal(t, 18535517, standing.Form[0].FixtureID)
+	assert.Equal(t, "W", standing.Form[0].Form)
+	assert.Equal(t, 38, standing.Form[0].SortOrder)
+}
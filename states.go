@@ -0,0 +1,34 @@
+package sportmonks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// States fetches FixtureState resources. The endpoint used within this method is paginated, to select the required
+// page use the 'page' method argument. Pagination information including current page and count are included within
+// the Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) States(ctx context.Context, page int, query *Query) ([]FixtureState, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[FixtureState](ctx, c, statesURI, values)
+}
+
+// StatesIter returns an iterator over FixtureState resources that transparently requests each page of the paginated
+// endpoint in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) StatesIter(ctx context.Context, query *Query) iter.Seq2[FixtureState, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]FixtureState, *ResponseDetails, error) {
+		return c.States(ctx, page, query)
+	})
+}
+
+// StateByID fetches a FixtureState resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) StateByID(ctx context.Context, id int, query *Query) (*FixtureState, *ResponseDetails, error) {
+	path := fmt.Sprintf(statesURI+"/%d", id)
+
+	return getOne[FixtureState](ctx, c, path, query.Values())
+}
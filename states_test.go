@@ -0,0 +1,127 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var statesResponse = `{
+	"data": [
+		{
+			"id": 1,
+			"state": "NS",
+			"name": "Not Started",
+			"short_name": "NS",
+			"developer_name": "NS"
+		},
+		{
+			"id": 5,
+			"state": "FT",
+			"name": "Full Time",
+			"short_name": "FT",
+			"developer_name": "FT"
+		}
+	],
+	"pagination": {
+		"count": 2,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "State"
+	},
+	"timezone": "UTC"
+}`
+
+var stateResponse = `{
+	"data": {
+		"id": 5,
+		"state": "FT",
+		"name": "Full Time",
+		"short_name": "FT",
+		"developer_name": "FT"
+	},
+	"timezone": "UTC"
+}`
+
+func TestStates(t *testing.T) {
+	url := defaultBaseURL + "/football/states?api_token=api-key&page=1"
+
+	t.Run("returns FixtureState struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, statesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		states, details, err := client.States(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(states))
+		assert.Equal(t, "Not Started", states[0].Name)
+		assertFullTimeState(t, &states[1])
+		assert.Equal(t, "State", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		states, _, err := client.States(context.Background(), 1, nil)
+
+		if states != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", states)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestStateByID(t *testing.T) {
+	url := defaultBaseURL + "/football/states/5?api_token=api-key"
+
+	t.Run("returns a single FixtureState struct", func(t *testing.T) {
+		server := mockResponseServer(t, stateResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		state, _, err := client.StateByID(context.Background(), 5, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertFullTimeState(t, state)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		state, _, err := client.StateByID(context.Background(), 5, nil)
+
+		if state != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", state)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func assertFullTimeState(t *testing.T, state *FixtureState) {
+	assert.Equal(t, 5, state.ID)
+	assert.Equal(t, "FT", state.State)
+	assert.Equal(t, "Full Time", state.Name)
+	assert.Equal(t, "FT", state.ShortName)
+	assert.Equal(t, "FT", state.DeveloperName)
+	assert.True(t, state.IsFinished())
+}
@@ -57,13 +57,13 @@ var teamSquadsIncludesResponse = `{
 
 func TestTeamSquad(t *testing.T) {
 	t.Run("returns a slice of SquadPlayer struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/squads/seasons/12962/teams/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/squads/seasons/12962/teams/1?api_token=api-key"
 
 		server := mockResponseServer(t, teamSquadsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, []string{})
+		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -79,7 +79,7 @@ func TestTeamSquad(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, []string{"player"})
+		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, NewQuery().Include("player"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -89,13 +89,13 @@ func TestTeamSquad(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/squads/seasons/12962/teams/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/squads/seasons/12962/teams/1?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, []string{})
+		squad, _, err := client.TeamSquad(context.Background(), 12962, 1, nil)
 
 		if squad != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", squad)
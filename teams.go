@@ -3,8 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 )
 
 // Team provides a struct representation of a Team resource.
@@ -28,60 +26,20 @@ type Team struct {
 	Seasons      []Season         `json:"seasons,omitempty"`
 }
 
-// TeamByID fetches a Team resource by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) TeamByID(ctx context.Context, id int, includes []string, filters map[string][]int) (*Team, *ResponseDetails, error) {
+// TeamByID fetches a Team resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) TeamByID(ctx context.Context, id int, query *Query) (*Team, *ResponseDetails, error) {
 	path := fmt.Sprintf(teamsURI+"/%d", id)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	values := query.Values()
 
-	formatFilters(&values, filters)
-
-	response := struct {
-		Data         *Team          `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[Team](ctx, c, path, values)
 }
 
-// TeamsBySeasonID fetches Team resources associated to a Season ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) TeamsBySeasonID(ctx context.Context, seasonID int, includes []string) ([]Team, *ResponseDetails, error) {
+// TeamsBySeasonID fetches Team resources associated to a Season ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) TeamsBySeasonID(ctx context.Context, seasonID int, query *Query) ([]Team, *ResponseDetails, error) {
 	path := fmt.Sprintf(teamsSeasonURI+"/%d", seasonID)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
-
-	response := struct {
-		Data         []Team         `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
+	values := query.Values()
 
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getMany[Team](ctx, c, path, values)
 }
@@ -158,13 +158,13 @@ var teamsIncludesResponse = `{
 
 func TestTeamByID(t *testing.T) {
 	t.Run("returns a single Team struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/1?api_token=api-key"
 
 		server := mockResponseServer(t, teamResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		team, _, err := client.TeamByID(context.Background(), 1, []string{}, map[string][]int{})
+		team, _, err := client.TeamByID(context.Background(), 1, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -180,7 +180,7 @@ func TestTeamByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		team, _, err := client.TeamByID(context.Background(), 1, []string{"squad", "league"}, map[string][]int{})
+		team, _, err := client.TeamByID(context.Background(), 1, NewQuery().Include("squad", "league"))
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -190,7 +190,7 @@ func TestTeamByID(t *testing.T) {
 	})
 
 	t.Run("returns a single Team struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/1?api_token=api-key&include=squad%3Bleague&seasons=4%2C56"
+		url := defaultBaseURL + "/football/teams/1?api_token=api-key&filters=seasons%3A4%2C56&include=squad%3Bleague"
 
 		server := mockResponseServer(t, teamIncludesResponse, 200, url)
 
@@ -199,13 +199,7 @@ func TestTeamByID(t *testing.T) {
 		team, _, err := client.TeamByID(
 			context.Background(),
 			1,
-			[]string{"squad", "league"},
-			map[string][]int{
-				"seasons": {
-					4,
-					56,
-				},
-			},
+			NewQuery().Include("squad", "league").Filter("seasons", 4, 56),
 		)
 
 		if err != nil {
@@ -216,13 +210,13 @@ func TestTeamByID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/1?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		team, _, err := client.TeamByID(context.Background(), 1, []string{}, map[string][]int{})
+		team, _, err := client.TeamByID(context.Background(), 1, nil)
 
 		if team != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", team)
@@ -232,13 +226,13 @@ func TestTeamByID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/1?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/1?api_token=api-key"
 
 		server := mockResponseServer(t, teamResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.TeamByID(context.Background(), 1, []string{}, map[string][]int{})
+		_, details, _ := client.TeamByID(context.Background(), 1, nil)
 
 		assertResponseDetails(t, details, "Team")
 	})
@@ -246,13 +240,13 @@ func TestTeamByID(t *testing.T) {
 
 func TestTeamsBySeasonID(t *testing.T) {
 	t.Run("returns a slice of Team struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key"
 
 		server := mockResponseServer(t, teamsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		teams, _, err := client.TeamsBySeasonID(context.Background(), 12962, []string{})
+		teams, _, err := client.TeamsBySeasonID(context.Background(), 12962, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -271,7 +265,7 @@ func TestTeamsBySeasonID(t *testing.T) {
 		teams, _, err := client.TeamsBySeasonID(
 			context.Background(),
 			12962,
-			[]string{"squad", "league"},
+			NewQuery().Include("squad", "league"),
 		)
 
 		if err != nil {
@@ -282,13 +276,13 @@ func TestTeamsBySeasonID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		teams, _, err := client.TeamsBySeasonID(context.Background(), 12962, []string{})
+		teams, _, err := client.TeamsBySeasonID(context.Background(), 12962, nil)
 
 		if teams != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", teams)
@@ -298,13 +292,13 @@ func TestTeamsBySeasonID(t *testing.T) {
 	})
 
 	t.Run("can handle response details response", func(t *testing.T) {
-		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key&include="
+		url := defaultBaseURL + "/football/teams/seasons/12962?api_token=api-key"
 
 		server := mockResponseServer(t, teamsResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.TeamsBySeasonID(context.Background(), 12962, []string{})
+		_, details, _ := client.TeamsBySeasonID(context.Background(), 12962, nil)
 
 		assertResponseDetails(t, details, "Team")
 	})
@@ -328,8 +322,8 @@ func assertSquadPlayer(t *testing.T, player *SquadPlayer) {
 	assert.Equal(t, 1, player.TeamID)
 	assert.Equal(t, 27, player.PositionID)
 	assert.Equal(t, 156, player.DetailedPositionID)
-	assert.Equal(t, "2020-01-31", player.Start)
-	assert.Equal(t, "2030-06-30", player.End)
+	assert.Equal(t, "2020-01-31", player.Start.String())
+	assert.Equal(t, "2030-06-30", player.End.String())
 	assert.Equal(t, true, player.Captain)
 	assert.Equal(t, 20, player.JerseyNumber)
 }
@@ -0,0 +1,221 @@
+package sportmonks
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const (
+	// DateTimeLayout is the layout of the date and time values returned by the API.
+	DateTimeLayout = "2006-01-02 15:04:05"
+	// DateLayout is the layout of the date values returned by the API.
+	DateLayout = "2006-01-02"
+)
+
+// timeLayouts are the layouts accepted when parsing a Time, the API returns some values in RFC 3339 format.
+var timeLayouts = []string{DateTimeLayout, time.RFC3339Nano, "2006-01-02T15:04:05", DateLayout}
+
+// dateLayouts are the layouts accepted when parsing a Date.
+var dateLayouts = []string{DateLayout, DateTimeLayout, time.RFC3339Nano}
+
+// Time is a date and time value returned by the API. Values without a UTC offset are in the TimeZone of the
+// ResponseDetails they were returned with. A null value decodes to the zero Time, which can be checked using IsZero.
+// A decoded Time encodes back to JSON exactly as it was received, unless its value has since been changed.
+type Time struct {
+	time.Time
+	raw string
+}
+
+// NewTime creates a new Time from t, encoded to JSON using DateTimeLayout.
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Time) UnmarshalJSON(b []byte) error {
+	parsed, err := parseJSONTime(b, timeLayouts, time.UTC)
+
+	if err != nil {
+		return err
+	}
+
+	*t = Time{Time: parsed, raw: string(b)}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return marshalJSONTime(t.Time, currentRaw(t.Time, t.raw, timeLayouts), DateTimeLayout)
+}
+
+// String returns the value as returned by the API, or formatted using DateTimeLayout when created using NewTime or
+// changed since it was decoded.
+func (t Time) String() string {
+	return formatTime(t.Time, currentRaw(t.Time, t.raw, timeLayouts), DateTimeLayout)
+}
+
+func (t *Time) localize(loc *time.Location) {
+	if parsed, err := parseJSONTime([]byte(t.raw), timeLayouts, loc); err == nil {
+		t.Time = parsed
+	}
+}
+
+// Date is a date value returned by the API, held as midnight at the start of the day in the TimeZone of the
+// ResponseDetails it was returned with. A null value decodes to the zero Date, which can be checked using IsZero.
+// A decoded Date encodes back to JSON exactly as it was received, unless its value has since been changed.
+type Date struct {
+	time.Time
+	raw string
+}
+
+// NewDate creates a new Date from t, encoded to JSON using DateLayout.
+func NewDate(t time.Time) Date {
+	return Date{Time: t}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Date) UnmarshalJSON(b []byte) error {
+	parsed, err := parseJSONTime(b, dateLayouts, time.UTC)
+
+	if err != nil {
+		return err
+	}
+
+	*d = Date{Time: parsed, raw: string(b)}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return marshalJSONTime(d.Time, currentRaw(d.Time, d.raw, dateLayouts), DateLayout)
+}
+
+// String returns the value as returned by the API, or formatted using DateLayout when created using NewDate or changed
+// since it was decoded.
+func (d Date) String() string {
+	return formatTime(d.Time, currentRaw(d.Time, d.raw, dateLayouts), DateLayout)
+}
+
+func (d *Date) localize(loc *time.Location) {
+	if parsed, err := parseJSONTime([]byte(d.raw), dateLayouts, loc); err == nil {
+		d.Time = parsed
+	}
+}
+
+// parseJSONTime parses a JSON string using the first matching layout, values without a UTC offset are parsed in loc.
+func parseJSONTime(b []byte, layouts []string, loc *time.Location) (time.Time, error) {
+	var s *string
+
+	if err := json.Unmarshal(b, &s); err != nil {
+		return time.Time{}, err
+	}
+
+	if s == nil || *s == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range layouts {
+		if t, err := time.ParseInLocation(layout, *s, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("cannot parse '%s' as a time value", *s)
+}
+
+// currentRaw returns the raw JSON a value was decoded from, or an empty string if the raw JSON no longer parses to t
+// because the value has been changed since it was decoded.
+func currentRaw(t time.Time, raw string, layouts []string) string {
+	if raw == "" {
+		return ""
+	}
+
+	parsed, err := parseJSONTime([]byte(raw), layouts, t.Location())
+
+	if err != nil || !parsed.Equal(t) {
+		return ""
+	}
+
+	return raw
+}
+
+func marshalJSONTime(t time.Time, raw, layout string) ([]byte, error) {
+	if raw != "" {
+		return []byte(raw), nil
+	}
+
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(t.Format(layout))
+}
+
+func formatTime(t time.Time, raw, layout string) string {
+	if raw != "" {
+		var s string
+
+		if json.Unmarshal([]byte(raw), &s) == nil {
+			return s
+		}
+
+		return ""
+	}
+
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(layout)
+}
+
+// localizer is implemented by the time values that are returned in the TimeZone of a response.
+type localizer interface {
+	localize(loc *time.Location)
+}
+
+var localizerType = reflect.TypeOf((*localizer)(nil)).Elem()
+
+// localize moves every Time and Date within v, which must be a pointer, into the given time zone.
+func localize(v interface{}, tz string) {
+	if tz == "" || strings.EqualFold(tz, "UTC") {
+		return
+	}
+
+	loc, err := time.LoadLocation(tz)
+
+	if err != nil {
+		return
+	}
+
+	localizeValue(reflect.ValueOf(v), loc)
+}
+
+func localizeValue(v reflect.Value, loc *time.Location) {
+	if v.CanAddr() && v.Addr().Type().Implements(localizerType) {
+		v.Addr().Interface().(localizer).localize(loc)
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			localizeValue(v.Elem(), loc)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			localizeValue(v.Index(i), loc)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				localizeValue(v.Field(i), loc)
+			}
+		}
+	}
+}
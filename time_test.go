@@ -0,0 +1,195 @@
+package sportmonks
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fixtureTimeZoneResponse = `{
+	"data": {
+		"id": 19134492,
+		"starting_at": "2024-08-17 15:00:00",
+		"starting_at_timestamp": 1723903200,
+		"season": {"id": 23614, "starting_at": "2024-08-16", "ending_at": null}
+	},
+	"timezone": "Europe/London"
+}`
+
+func TestTime(t *testing.T) {
+	t.Run("parses the API date and time formats", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			input string
+			want  time.Time
+		}{
+			{"date time", `"2024-08-17 14:00:00"`, time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC)},
+			{"rfc 3339", `"2023-10-01T12:00:00Z"`, time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)},
+			{"rfc 3339 with fraction", `"2023-10-01T12:00:00.000000Z"`, time.Date(2023, 10, 1, 12, 0, 0, 0, time.UTC)},
+			{"date", `"2024-08-17"`, time.Date(2024, 8, 17, 0, 0, 0, 0, time.UTC)},
+			{"null", `null`, time.Time{}},
+			{"empty", `""`, time.Time{}},
+		}
+
+		for _, tc := range tests {
+			var v Time
+
+			if err := json.Unmarshal([]byte(tc.input), &v); err != nil {
+				t.Fatalf("Test failed for %s, expected nil, got %s", tc.name, err.Error())
+			}
+
+			assert.True(t, tc.want.Equal(v.Time), tc.name)
+		}
+	})
+
+	t.Run("returns an error for an invalid value", func(t *testing.T) {
+		var v Time
+
+		assert.NotNil(t, json.Unmarshal([]byte(`"17/08/2024"`), &v))
+		assert.NotNil(t, json.Unmarshal([]byte(`1723903200`), &v))
+	})
+
+	t.Run("marshals identically to the decoded value", func(t *testing.T) {
+		input := `{"a":"2024-08-17 14:00:00","b":"2023-10-01T12:00:00.000000Z","c":null,"d":""}`
+
+		var v struct {
+			A Time `json:"a"`
+			B Time `json:"b"`
+			C Time `json:"c"`
+			D Time `json:"d"`
+		}
+
+		if err := json.Unmarshal([]byte(input), &v); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, input, string(b))
+		assert.Equal(t, "2023-10-01T12:00:00.000000Z", v.B.String())
+	})
+
+	t.Run("a changed value marshals using the API layout", func(t *testing.T) {
+		var v struct {
+			A Time `json:"a"`
+			B Time `json:"b"`
+		}
+
+		if err := json.Unmarshal([]byte(`{"a":"2023-10-01T12:00:00.000000Z","b":"2024-08-17 14:00:00"}`), &v); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		v.A.Time = v.A.Add(90 * time.Minute)
+		v.B.Time = time.Time{}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `{"a":"2023-10-01 13:30:00","b":null}`, string(b))
+		assert.Equal(t, "2023-10-01 13:30:00", v.A.String())
+
+		var decoded struct {
+			A Time `json:"a"`
+		}
+
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, v.A.Equal(decoded.A.Time))
+	})
+
+	t.Run("a new time marshals using the API layout", func(t *testing.T) {
+		b, err := json.Marshal(NewTime(time.Date(2024, 8, 17, 14, 0, 0, 0, time.UTC)))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `"2024-08-17 14:00:00"`, string(b))
+
+		b, _ = json.Marshal(Time{})
+
+		assert.Equal(t, `null`, string(b))
+	})
+}
+
+func TestDate(t *testing.T) {
+	t.Run("parses the API date formats", func(t *testing.T) {
+		var v Date
+
+		if err := json.Unmarshal([]byte(`"1979-10-25"`), &v); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, time.Date(1979, 10, 25, 0, 0, 0, 0, time.UTC), v.Time)
+		assert.Equal(t, "1979-10-25", v.String())
+
+		if err := json.Unmarshal([]byte(`null`), &v); err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.True(t, v.IsZero())
+		assert.Equal(t, "", v.String())
+	})
+
+	t.Run("a new date marshals using the API layout", func(t *testing.T) {
+		b, err := json.Marshal(NewDate(time.Date(2024, 8, 16, 0, 0, 0, 0, time.UTC)))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, `"2024-08-16"`, string(b))
+	})
+}
+
+func TestResponseTimeZone(t *testing.T) {
+	t.Run("times are parsed in the response time zone", func(t *testing.T) {
+		url := defaultBaseURL + "/football/fixtures/19134492?api_token=api-key&include=season"
+
+		server := mockResponseServer(t, fixtureTimeZoneResponse, http.StatusOK, url)
+
+		client := newTestHTTPClient(server)
+
+		fixture, _, err := client.FixtureByID(context.Background(), 19134492, NewQuery().Include("season"))
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		london, _ := time.LoadLocation("Europe/London")
+
+		assert.Equal(t, london, fixture.StartingAt.Location())
+		assert.Equal(t, 15, fixture.StartingAt.Hour())
+		assert.True(t, time.Unix(1723903200, 0).Equal(fixture.Kickoff()))
+		assert.Equal(t, "2024-08-17 15:00:00", fixture.StartingAt.String())
+		assert.Equal(t, london, fixture.Season.StartingAt.Location())
+		assert.True(t, fixture.Season.EndingAt.IsZero())
+	})
+}
+
+func TestFixture_Kickoff(t *testing.T) {
+	t.Run("falls back to the starting timestamp", func(t *testing.T) {
+		fixture := Fixture{StartingAtTimestamp: 1723903200}
+
+		assert.Equal(t, time.Unix(1723903200, 0).UTC(), fixture.Kickoff())
+	})
+
+	t.Run("returns the zero time when no start is known", func(t *testing.T) {
+		fixture := Fixture{}
+
+		assert.True(t, fixture.Kickoff().IsZero())
+	})
+}
@@ -3,19 +3,18 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
-	"strings"
 )
 
 // TopScorer provides a struct representation of a TopScorer resource.
 type TopScorer struct {
-	ID            int `json:"id"`
-	SeasonID      int `json:"season_id"`
-	PlayerID      int `json:"player_id"`
-	TypeID        int `json:"type_id"`
-	Position      int `json:"position"`
-	Total         int `json:"total"`
-	ParticipantID int `json:"participant_id"`
+	ID            int   `json:"id"`
+	SeasonID      int   `json:"season_id"`
+	PlayerID      int   `json:"player_id"`
+	TypeID        int   `json:"type_id"`
+	Position      int   `json:"position"`
+	Total         int   `json:"total"`
+	ParticipantID int   `json:"participant_id"`
+	Type          *Type `json:"type,omitempty"`
 	//Season        *Season `json:"season,omitempty"`
 }
 
@@ -90,26 +89,11 @@ func (g *GoalScorer) Team() *Team {
 	return g.TeamData.Data
 }
 
-// TopScorersBySeasonID fetches a TopScorers resource for a season by ID. Use the includes slice of string to enrich the response data.
-func (c *HTTPClient) TopScorersBySeasonID(ctx context.Context, seasonID int, includes []string, filters map[string][]int) ([]TopScorer, *Meta, error) {
+// TopScorersBySeasonID fetches a TopScorers resource for a season by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) TopScorersBySeasonID(ctx context.Context, seasonID int, query *Query) ([]TopScorer, *ResponseDetails, error) {
 	path := fmt.Sprintf(topScorersSeasonURI+"/%d", seasonID)
 
-	values := url.Values{
-		"include": {strings.Join(includes, ";")},
-	}
+	values := query.Values()
 
-	formatFilters(&values, filters)
-
-	response := struct {
-		Data []TopScorer `json:"data"`
-		Meta *Meta       `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, values, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getMany[TopScorer](ctx, c, path, values)
 }
@@ -36,13 +36,13 @@ var topScorersIncludesResponse = `{
 
 func TestTopScorersBySeasonID(t *testing.T) {
 	t.Run("returns a TopScorers struct", func(t *testing.T) {
-		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key&include="
+		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key"
 
 		server := mockResponseServer(t, topScorersResponse, 200, url)
 
 		client := newTestHTTPClient(server)
 
-		topscorers, _, err := client.TopScorersBySeasonID(context.Background(), 12962, []string{}, map[string][]int{})
+		topscorers, _, err := client.TopScorersBySeasonID(context.Background(), 12962, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -61,8 +61,7 @@ func TestTopScorersBySeasonID(t *testing.T) {
 		topscorers, _, err := client.TopScorersBySeasonID(
 			context.Background(),
 			12962,
-			[]string{"goalscorers.team", "cardscorers.player"},
-			map[string][]int{},
+			NewQuery().Include("goalscorers.team", "cardscorers.player"),
 		)
 
 		if err != nil {
@@ -73,7 +72,7 @@ func TestTopScorersBySeasonID(t *testing.T) {
 	})
 
 	t.Run("returns a TopScorers struct with includes data and filter parameters", func(t *testing.T) {
-		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key&include=goalscorers.team%3Bcardscorers.player&stage_ids=4%2C33"
+		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key&filters=stage_ids%3A4%2C33&include=goalscorers.team%3Bcardscorers.player"
 
 		server := mockResponseServer(t, topScorersIncludesResponse, 200, url)
 
@@ -82,13 +81,7 @@ func TestTopScorersBySeasonID(t *testing.T) {
 		topscorers, _, err := client.TopScorersBySeasonID(
 			context.Background(),
 			12962,
-			[]string{"goalscorers.team", "cardscorers.player"},
-			map[string][]int{
-				"stage_ids": {
-					4,
-					33,
-				},
-			},
+			NewQuery().Include("goalscorers.team", "cardscorers.player").Filter("stage_ids", 4, 33),
 		)
 
 		if err != nil {
@@ -99,13 +92,13 @@ func TestTopScorersBySeasonID(t *testing.T) {
 	})
 
 	t.Run("returns bad status code error", func(t *testing.T) {
-		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key&include="
+		url := defaultBaseURL + "/football/topscorers/seasons/12962?api_token=api-key"
 
 		server := mockResponseServer(t, errorResponse, 400, url)
 
 		client := newTestHTTPClient(server)
 
-		topscorers, _, err := client.TopScorersBySeasonID(context.Background(), 12962, []string{}, map[string][]int{})
+		topscorers, _, err := client.TopScorersBySeasonID(context.Background(), 12962, nil)
 
 		if topscorers != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", topscorers)
@@ -3,7 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
 )
 
 // TVStation provides a struct representation of a TVStation resource.
@@ -17,19 +16,8 @@ type TVStation struct {
 }
 
 // TVStationsByFixtureID fetches TVStation resources for a fixture ID.
-func (c *HTTPClient) TVStationsByFixtureID(ctx context.Context, fixtureID int) ([]TVStation, *Meta, error) {
+func (c *HTTPClient) TVStationsByFixtureID(ctx context.Context, fixtureID int, query *Query) ([]TVStation, *ResponseDetails, error) {
 	path := fmt.Sprintf(tvStationsURI+"/%d", fixtureID)
 
-	response := struct {
-		Data []TVStation `json:"data"`
-		Meta *Meta       `json:"meta"`
-	}{}
-
-	err := c.getResource(ctx, path, url.Values{}, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, response.Meta, err
+	return getMany[TVStation](ctx, c, path, query.Values())
 }
@@ -27,7 +27,7 @@ func TestTVStationsByFixtureID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		tv, _, err := client.TVStationsByFixtureID(context.Background(), 11867285)
+		tv, _, err := client.TVStationsByFixtureID(context.Background(), 11867285, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -43,7 +43,7 @@ func TestTVStationsByFixtureID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		tv, _, err := client.TVStationsByFixtureID(context.Background(), 11867285)
+		tv, _, err := client.TVStationsByFixtureID(context.Background(), 11867285, nil)
 
 		if tv != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", tv)
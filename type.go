@@ -226,20 +226,21 @@ type (
 
 	// LineupPlayer provides information for a player of a team for a fixture.
 	LineupPlayer struct {
-		ID                int            `json:"id"`
-		SportID           int            `json:"sport_id"`
-		FixtureID         int            `json:"fixture_id"`
-		PlayerID          int            `json:"player_id"`
-		TeamID            int            `json:"team_id"`
-		PositionID        int            `json:"position_id"`
-		FormationField    *string        `json:"formation_field"`
-		TypeID            int            `json:"type_id"`
-		FormationPosition int            `json:"formation_position"`
-		PlayerName        string         `json:"player_name"`
-		JerseyNumber      int            `json:"jersey_number"`
-		Details           []LineupDetail `json:"details,omitempty"`
-		Position          *Position      `json:"position,omitempty"`
-		Player            *Player        `json:"player,omitempty"`
+		ID                int             `json:"id"`
+		SportID           int             `json:"sport_id"`
+		FixtureID         int             `json:"fixture_id"`
+		PlayerID          int             `json:"player_id"`
+		TeamID            int             `json:"team_id"`
+		PositionID        int             `json:"position_id"`
+		FormationField    *string         `json:"formation_field"`
+		TypeID            int             `json:"type_id"`
+		FormationPosition int             `json:"formation_position"`
+		PlayerName        string          `json:"player_name"`
+		JerseyNumber      int             `json:"jersey_number"`
+		Details           []LineupDetail  `json:"details,omitempty"`
+		Position          *Position       `json:"position,omitempty"`
+		Player            *Player         `json:"player,omitempty"`
+		ExpectedGoals     []ExpectedGoals `json:"xglineup,omitempty"`
 	}
 
 	LineupDetail struct {
@@ -254,6 +255,8 @@ type (
 	}
 
 	// LiveStandings provides league standing information for a team.
+	//
+	// Deprecated: LiveStandings matches the v2 API payload, use Standing instead.
 	LiveStandings struct {
 		Position           int    `json:"position"`
 		Played             int    `json:"played"`
@@ -322,6 +325,23 @@ type (
 		Saves     *int `json:"saves"`
 	}
 
+	// Period provides timing details of a period of play within a fixture.
+	Period struct {
+		ID           int    `json:"id"`
+		FixtureID    int    `json:"fixture_id"`
+		TypeID       int    `json:"type_id"`
+		Started      *int64 `json:"started"`
+		Ended        *int64 `json:"ended"`
+		CountsFrom   int    `json:"counts_from"`
+		Ticking      bool   `json:"ticking"`
+		SortOrder    int    `json:"sort_order"`
+		Description  string `json:"description"`
+		TimeAdded    *int   `json:"time_added"`
+		PeriodLength int    `json:"period_length"`
+		Minutes      *int   `json:"minutes"`
+		Seconds      *int   `json:"seconds"`
+	}
+
 	// PlayerStats provides basic player data linked to stats.
 	PlayerStats struct {
 		TeamID             int              `json:"team_id"`
@@ -615,6 +635,8 @@ type (
 	}
 
 	// TeamStandings provides current league standings for teams in a fixture.
+	//
+	// Deprecated: TeamStandings matches the v2 API payload, use Standing instead.
 	TeamStandings struct {
 		LocalTeamPosition   int `json:"localteam_position"`
 		VisitorTeamPosition int `json:"visitorteam_position"`
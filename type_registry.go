@@ -0,0 +1,146 @@
+package sportmonks
+
+import (
+	"context"
+	"sync"
+)
+
+// TypeRegistry holds every Type resource, loaded once, and resolves the TypeID of resources to their Type without
+// spending the include budget of each request on the 'type' include. A TypeRegistry is safe for concurrent use.
+type TypeRegistry struct {
+	client Client
+	// load serialises calls to Load, so the catalog is fetched once without holding mu during the requests.
+	load   sync.Mutex
+	mu     sync.Mutex
+	loaded bool
+	types  map[int]Type
+}
+
+// NewTypeRegistry creates a new TypeRegistry loading types using the client.
+func NewTypeRegistry(client Client) *TypeRegistry {
+	return &TypeRegistry{client: client, types: map[int]Type{}}
+}
+
+// Load fetches every Type resource. Only the first successful call makes requests, a failed load is retried by the
+// next call. Types can be resolved while a load is in progress.
+func (r *TypeRegistry) Load(ctx context.Context) error {
+	r.load.Lock()
+	defer r.load.Unlock()
+
+	r.mu.Lock()
+	loaded := r.loaded
+	r.mu.Unlock()
+
+	if loaded {
+		return nil
+	}
+
+	types, err := Collect(r.client.TypesIter(ctx, nil), 0)
+
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range types {
+		r.types[t.ID] = t
+	}
+
+	r.loaded = true
+
+	return nil
+}
+
+// Add stores types in the registry without loading the catalog, e.g. to resolve types offline.
+func (r *TypeRegistry) Add(types ...Type) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, t := range types {
+		r.types[t.ID] = t
+	}
+}
+
+// Type returns the Type with the given ID. The boolean is false if the ID is unknown or the registry has not been
+// loaded.
+func (r *TypeRegistry) Type(id int) (Type, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.types[id]
+
+	return t, ok
+}
+
+// ResolveTypes populates the Type of each event, statistic, score and lineup detail of the fixture using the registry.
+// Types already populated, e.g. by the 'type' include, and unknown types are left unchanged.
+func (f *Fixture) ResolveTypes(reg *TypeRegistry) {
+	for i := range f.Events {
+		e := &f.Events[i]
+
+		if t, ok := reg.Type(e.TypeID); ok && e.Type == nil {
+			e.Type = t.EventType()
+		}
+	}
+
+	for i := range f.Statistics {
+		s := &f.Statistics[i]
+
+		if t, ok := reg.Type(s.TypeID); ok && s.Type == nil {
+			s.Type = t.StatType()
+		}
+	}
+
+	for i := range f.Scores {
+		s := &f.Scores[i]
+
+		if t, ok := reg.Type(s.TypeID); ok && s.Type == nil {
+			s.Type = t.ScoreType()
+		}
+	}
+
+	for i := range f.Lineups {
+		for j := range f.Lineups[i].Details {
+			d := &f.Lineups[i].Details[j]
+
+			if t, ok := reg.Type(d.TypeID); ok && d.Type == nil {
+				d.Type = t.StatType()
+			}
+		}
+	}
+}
+
+// ResolveTypes populates the Type of each detail and of the rule of the standing using the registry. Types already
+// populated and unknown types are left unchanged.
+func (s *Standing) ResolveTypes(reg *TypeRegistry) {
+	for i := range s.Details {
+		d := &s.Details[i]
+
+		if t, ok := reg.Type(d.TypeID); ok && d.Type == nil {
+			d.Type = t.StandingType()
+		}
+	}
+
+	if s.Rule != nil && s.Rule.Type == nil {
+		if t, ok := reg.Type(s.Rule.TypeID); ok {
+			s.Rule.Type = t.StandingType()
+		}
+	}
+}
+
+// ResolveTypes populates the Type of the top scorer using the registry. A Type already populated or unknown is left
+// unchanged.
+func (s *TopScorer) ResolveTypes(reg *TypeRegistry) {
+	if t, ok := reg.Type(s.TypeID); ok && s.Type == nil {
+		s.Type = &t
+	}
+}
+
+// ResolveType returns the Type of the statistic using the registry. The Type field of a SeasonStatistic holds the name
+// of the type as returned by the API, so the resolved Type is returned rather than populated. The boolean is false if
+// the type is unknown.
+func (s *SeasonStatistic) ResolveType(reg *TypeRegistry) (Type, bool) {
+	return reg.Type(s.TypeID)
+}
@@ -0,0 +1,147 @@
+package sportmonks
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeRegistry(t *testing.T) {
+	t.Run("loads the catalog once", func(t *testing.T) {
+		var mu sync.Mutex
+		calls := 0
+
+		server := newTestClient(func(req *http.Request) *http.Response {
+			mu.Lock()
+			defer mu.Unlock()
+
+			calls++
+
+			return stringResponse(200, typesResponse)
+		})
+
+		reg := NewTypeRegistry(newTestHTTPClient(server))
+
+		_, ok := reg.Type(14)
+
+		assert.False(t, ok)
+		assert.Nil(t, reg.Load(context.Background()))
+		assert.Nil(t, reg.Load(context.Background()))
+		assert.Equal(t, 1, calls)
+
+		typ, ok := reg.Type(14)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Goal", typ.Name)
+	})
+
+	t.Run("resolves types while a load is in progress", func(t *testing.T) {
+		requested := make(chan struct{})
+		release := make(chan struct{})
+
+		server := newTestClient(func(req *http.Request) *http.Response {
+			close(requested)
+			<-release
+
+			return stringResponse(200, typesResponse)
+		})
+
+		reg := NewTypeRegistry(newTestHTTPClient(server))
+		reg.Add(Type{ID: 1, Name: "1st Half"})
+
+		done := make(chan error)
+
+		go func() {
+			done <- reg.Load(context.Background())
+		}()
+
+		<-requested
+
+		typ, ok := reg.Type(1)
+
+		assert.True(t, ok)
+		assert.Equal(t, "1st Half", typ.Name)
+
+		close(release)
+
+		assert.Nil(t, <-done)
+
+		_, ok = reg.Type(14)
+
+		assert.True(t, ok)
+	})
+
+	t.Run("returns the error of a failed load", func(t *testing.T) {
+		server := newTestClient(func(req *http.Request) *http.Response {
+			return stringResponse(400, errorResponse)
+		})
+
+		reg := NewTypeRegistry(newTestHTTPClient(server))
+
+		assertError(t, reg.Load(context.Background()))
+	})
+}
+
+func TestResolveTypes(t *testing.T) {
+	offensive := "offensive"
+
+	reg := NewTypeRegistry(nil)
+
+	reg.Add(
+		Type{ID: 14, Name: "Goal", DeveloperName: "GOAL", ModelType: "event"},
+		Type{ID: 52, Name: "Goals", DeveloperName: "GOALS", ModelType: "statistic", StatGroup: &offensive},
+		Type{ID: 1525, Name: "Current", DeveloperName: "CURRENT", ModelType: "score"},
+		Type{ID: 129, Name: "Overall Matches", DeveloperName: "OVERALL_MATCHES", ModelType: "standing_detail"},
+		Type{ID: 180, Name: "Promotion", DeveloperName: "PROMOTION", ModelType: "standing_rule"},
+		Type{ID: 208, Name: "Goal Topscorer", DeveloperName: "GOAL_TOPSCORER", ModelType: "topscorer"},
+	)
+
+	t.Run("resolves the types of a fixture", func(t *testing.T) {
+		fixture := Fixture{
+			Events:     []FixtureEvent{{TypeID: 14}, {TypeID: 99}, {TypeID: 14, Type: &FixtureEventType{ID: 14, Name: "Included"}}},
+			Statistics: []FixtureStat{{TypeID: 52}},
+			Scores:     []Score{{TypeID: 1525}},
+			Lineups:    []LineupPlayer{{Details: []LineupDetail{{TypeID: 52}}}},
+		}
+
+		fixture.ResolveTypes(reg)
+
+		assert.Equal(t, "GOAL", fixture.Events[0].Type.DeveloperName)
+		assert.Nil(t, fixture.Events[1].Type)
+		assert.Equal(t, "Included", fixture.Events[2].Type.Name)
+		assert.Equal(t, "Goals", fixture.Statistics[0].Type.Name)
+		assert.Equal(t, "offensive", fixture.Statistics[0].Type.StatGroup)
+		assert.Equal(t, "CURRENT", fixture.Scores[0].Type.DeveloperName)
+		assert.Equal(t, 52, fixture.Lineups[0].Details[0].Type.ID)
+	})
+
+	t.Run("resolves the types of a standing", func(t *testing.T) {
+		standing := Standing{
+			Details: []StandingDetail{{TypeID: 129}},
+			Rule:    &StandingRule{TypeID: 180},
+		}
+
+		standing.ResolveTypes(reg)
+
+		assert.Equal(t, "Overall Matches", standing.Details[0].Type.Name)
+		assert.Equal(t, "PROMOTION", standing.Rule.Type.DeveloperName)
+	})
+
+	t.Run("resolves the types of top scorers and season statistics", func(t *testing.T) {
+		scorer := TopScorer{TypeID: 208}
+
+		scorer.ResolveTypes(reg)
+
+		assert.Equal(t, "GOAL_TOPSCORER", scorer.Type.DeveloperName)
+
+		stat := SeasonStatistic{TypeID: 52}
+
+		typ, ok := stat.ResolveType(reg)
+
+		assert.True(t, ok)
+		assert.Equal(t, "Goals", typ.Name)
+	})
+}
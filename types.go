@@ -0,0 +1,111 @@
+package sportmonks
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// Type provides a struct representation of a Type resource, describing the meaning of the TypeID of events,
+// statistics, scores and other resources.
+type Type struct {
+	ID            int     `json:"id"`
+	ParentID      *int    `json:"parent_id"`
+	Name          string  `json:"name"`
+	Code          string  `json:"code"`
+	DeveloperName string  `json:"developer_name"`
+	ModelType     string  `json:"model_type"`
+	StatGroup     *string `json:"stat_group"`
+}
+
+// EventType returns the type as a FixtureEventType.
+func (t *Type) EventType() *FixtureEventType {
+	return &FixtureEventType{
+		ID:            t.ID,
+		Name:          t.Name,
+		Code:          t.Code,
+		DeveloperName: t.DeveloperName,
+		ModelType:     t.ModelType,
+		StatGroup:     t.StatGroup,
+	}
+}
+
+// StatType returns the type as a StatType.
+func (t *Type) StatType() *StatType {
+	s := &StatType{
+		ID:            t.ID,
+		Name:          t.Name,
+		Code:          t.Code,
+		DeveloperName: t.DeveloperName,
+		ModelType:     t.ModelType,
+	}
+
+	if t.StatGroup != nil {
+		s.StatGroup = *t.StatGroup
+	}
+
+	return s
+}
+
+// ScoreType returns the type as a ScoreType.
+func (t *Type) ScoreType() *ScoreType {
+	return &ScoreType{
+		ID:            t.ID,
+		Name:          t.Name,
+		Code:          t.Code,
+		DeveloperName: t.DeveloperName,
+		ModelType:     t.ModelType,
+		StatGroup:     t.StatGroup,
+	}
+}
+
+// StandingType returns the type as a StandingType.
+func (t *Type) StandingType() *StandingType {
+	return &StandingType{
+		ID:            t.ID,
+		Name:          t.Name,
+		Code:          t.Code,
+		DeveloperName: t.DeveloperName,
+		ModelType:     t.ModelType,
+		StatGroup:     t.StatGroup,
+	}
+}
+
+// Types fetches Type resources. The endpoint used within this method is paginated, to select the required page use
+// the 'page' method argument. Pagination information including current page and count are included within the
+// Pagination struct with the ResponseDetails struct. Use the query to enrich and filter the response data.
+func (c *HTTPClient) Types(ctx context.Context, page int, query *Query) ([]Type, *ResponseDetails, error) {
+	values := query.Values()
+
+	values.Set("page", strconv.Itoa(page))
+
+	return getMany[Type](ctx, c, typesURI, values)
+}
+
+// TypesIter returns an iterator over Type resources that transparently requests each page of the paginated endpoint
+// in turn. Use the query to enrich and filter the response data.
+func (c *HTTPClient) TypesIter(ctx context.Context, query *Query) iter.Seq2[Type, error] {
+	return Paginate(ctx, func(ctx context.Context, page int) ([]Type, *ResponseDetails, error) {
+		return c.Types(ctx, page, query)
+	})
+}
+
+// TypeByID fetches a Type resource by ID. Use the query to enrich and filter the response data.
+func (c *HTTPClient) TypeByID(ctx context.Context, id int, query *Query) (*Type, *ResponseDetails, error) {
+	path := fmt.Sprintf(typesURI+"/%d", id)
+
+	return getOne[Type](ctx, c, path, query.Values())
+}
+
+// TypesByEntity fetches the Type resources used by each entity, keyed by entity name such as 'FixtureStatistic'. Use
+// the query to enrich and filter the response data.
+func (c *HTTPClient) TypesByEntity(ctx context.Context, query *Query) (map[string][]Type, *ResponseDetails, error) {
+	e, err := getEnvelope[map[string][]Type](ctx, c, typesEntitiesURI, query.Values())
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e.Data, &e.ResponseDetails, nil
+}
@@ -0,0 +1,179 @@
+package sportmonks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var typesResponse = `{
+	"data": [
+		{
+			"id": 14,
+			"parent_id": null,
+			"name": "Goal",
+			"code": "goal",
+			"developer_name": "GOAL",
+			"model_type": "event",
+			"stat_group": null
+		},
+		{
+			"id": 52,
+			"parent_id": null,
+			"name": "Goals",
+			"code": "goals",
+			"developer_name": "GOALS",
+			"model_type": "statistic",
+			"stat_group": "offensive"
+		}
+	],
+	"pagination": {
+		"count": 2,
+		"per_page": 25,
+		"current_page": 1,
+		"next_page": null,
+		"has_more": false
+	},
+	"rate_limit": {
+		"resets_in_seconds": 3386,
+		"remaining": 2997,
+		"requested_entity": "Type"
+	},
+	"timezone": "UTC"
+}`
+
+var typeResponse = `{
+	"data": {
+		"id": 14,
+		"parent_id": null,
+		"name": "Goal",
+		"code": "goal",
+		"developer_name": "GOAL",
+		"model_type": "event",
+		"stat_group": null
+	},
+	"timezone": "UTC"
+}`
+
+var typesByEntityResponse = `{
+	"data": {
+		"FixtureStatistic": [
+			{"id": 52, "parent_id": null, "name": "Goals", "code": "goals", "developer_name": "GOALS", "model_type": "statistic", "stat_group": "offensive"}
+		],
+		"Event": [
+			{"id": 14, "parent_id": null, "name": "Goal", "code": "goal", "developer_name": "GOAL", "model_type": "event", "stat_group": null}
+		]
+	},
+	"timezone": "UTC"
+}`
+
+func TestTypes(t *testing.T) {
+	url := defaultBaseURL + "/core/types?api_token=api-key&page=1"
+
+	t.Run("returns Type struct slice", func(t *testing.T) {
+		server := mockResponseServer(t, typesResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		types, details, err := client.Types(context.Background(), 1, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertGoalType(t, &types[0])
+		assert.Equal(t, "offensive", *types[1].StatGroup)
+		assert.Equal(t, "Type", details.RateLimit.RequestedEntity)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		types, _, err := client.Types(context.Background(), 1, nil)
+
+		if types != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", types)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestTypeByID(t *testing.T) {
+	url := defaultBaseURL + "/core/types/14?api_token=api-key"
+
+	t.Run("returns a single Type struct", func(t *testing.T) {
+		server := mockResponseServer(t, typeResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		typ, _, err := client.TypeByID(context.Background(), 14, nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assertGoalType(t, typ)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		typ, _, err := client.TypeByID(context.Background(), 14, nil)
+
+		if typ != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", typ)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func TestTypesByEntity(t *testing.T) {
+	url := defaultBaseURL + "/core/types/entities?api_token=api-key"
+
+	t.Run("returns the types of each entity", func(t *testing.T) {
+		server := mockResponseServer(t, typesByEntityResponse, 200, url)
+
+		client := newTestHTTPClient(server)
+
+		entities, _, err := client.TypesByEntity(context.Background(), nil)
+
+		if err != nil {
+			t.Fatalf("Test failed, expected nil, got %s", err.Error())
+		}
+
+		assert.Equal(t, 2, len(entities))
+		assertGoalType(t, &entities["Event"][0])
+		assert.Equal(t, 52, entities["FixtureStatistic"][0].ID)
+	})
+
+	t.Run("returns bad status code error", func(t *testing.T) {
+		server := mockResponseServer(t, errorResponse, 400, url)
+
+		client := newTestHTTPClient(server)
+
+		entities, _, err := client.TypesByEntity(context.Background(), nil)
+
+		if entities != nil {
+			t.Fatalf("Test failed, expected nil, got %+v", entities)
+		}
+
+		assertError(t, err)
+	})
+}
+
+func assertGoalType(t *testing.T, typ *Type) {
+	assert.Equal(t, 14, typ.ID)
+	assert.Nil(t, typ.ParentID)
+	assert.Equal(t, "Goal", typ.Name)
+	assert.Equal(t, "goal", typ.Code)
+	assert.Equal(t, "GOAL", typ.DeveloperName)
+	assert.Equal(t, "event", typ.ModelType)
+	assert.Nil(t, typ.StatGroup)
+}
@@ -3,7 +3,6 @@ package sportmonks
 import (
 	"context"
 	"fmt"
-	"net/url"
 )
 
 type Venue struct {
@@ -23,49 +22,15 @@ type Venue struct {
 }
 
 // VenueByID fetches a Venue resource by ID.
-func (c *HTTPClient) VenueByID(ctx context.Context, id int) (*Venue, *ResponseDetails, error) {
+func (c *HTTPClient) VenueByID(ctx context.Context, id int, query *Query) (*Venue, *ResponseDetails, error) {
 	path := fmt.Sprintf(venuesURI+"/%d", id)
 
-	response := struct {
-		Data         *Venue         `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, url.Values{}, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getOne[Venue](ctx, c, path, query.Values())
 }
 
 // VenuesBySeasonID fetches a Venue resource by season ID.
-func (c *HTTPClient) VenuesBySeasonID(ctx context.Context, id int) ([]Venue, *ResponseDetails, error) {
+func (c *HTTPClient) VenuesBySeasonID(ctx context.Context, id int, query *Query) ([]Venue, *ResponseDetails, error) {
 	path := fmt.Sprintf(venuesSeasonURI+"/%d", id)
 
-	response := struct {
-		Data         []Venue        `json:"data"`
-		Subscription []Subscription `json:"subscription"`
-		RateLimit    RateLimit      `json:"rate_limit"`
-		TimeZone     string         `json:"timezone"`
-	}{}
-
-	err := c.getResource(ctx, path, url.Values{}, &response)
-
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return response.Data, &ResponseDetails{
-		Subscription: response.Subscription,
-		RateLimit:    response.RateLimit,
-		TimeZone:     response.TimeZone,
-	}, err
+	return getMany[Venue](ctx, c, path, query.Values())
 }
@@ -100,7 +100,7 @@ func TestVenueByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		venue, _, err := client.VenueByID(context.Background(), 200)
+		venue, _, err := client.VenueByID(context.Background(), 200, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -116,7 +116,7 @@ func TestVenueByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		venue, _, err := client.VenueByID(context.Background(), 200)
+		venue, _, err := client.VenueByID(context.Background(), 200, nil)
 
 		if venue != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", venue)
@@ -132,7 +132,7 @@ func TestVenueByID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.VenueByID(context.Background(), 200)
+		_, details, _ := client.VenueByID(context.Background(), 200, nil)
 
 		assertResponseDetails(t, details, "Venue")
 	})
@@ -146,7 +146,7 @@ func TestVenueBySeasonID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		venues, _, err := client.VenuesBySeasonID(context.Background(), 12962)
+		venues, _, err := client.VenuesBySeasonID(context.Background(), 12962, nil)
 
 		if err != nil {
 			t.Fatalf("Test failed, expected nil, got %s", err.Error())
@@ -162,7 +162,7 @@ func TestVenueBySeasonID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		venues, _, err := client.VenuesBySeasonID(context.Background(), 12962)
+		venues, _, err := client.VenuesBySeasonID(context.Background(), 12962, nil)
 
 		if venues != nil {
 			t.Fatalf("Test failed, expected nil, got %+v", venues)
@@ -178,7 +178,7 @@ func TestVenueBySeasonID(t *testing.T) {
 
 		client := newTestHTTPClient(server)
 
-		_, details, _ := client.VenuesBySeasonID(context.Background(), 12962)
+		_, details, _ := client.VenuesBySeasonID(context.Background(), 12962, nil)
 
 		assertResponseDetails(t, details, "Venue")
 	})